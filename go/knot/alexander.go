@@ -0,0 +1,97 @@
+package knot
+
+import (
+	"github.com/attilaolah/math/go/poly"
+)
+
+// Alexander calculates the Knot's normalized Alexander polynomial.
+// The polynomial is multiplied by ±tᵏ so that it is symmetric around t⁰ and takes the value 1 at t = 1.
+// If the diagram is not planar, nil is returned: the Alexander matrix of such a diagram does not describe a knot.
+func (k *Knot) Alexander() poly.Int64P {
+	if d := k.diagram(); len(d.faces()) != len(d.x)+2 && len(d.x) > 0 {
+		return nil
+	}
+
+	m := k.AlexanderMatrix()
+	if m == nil || m.Stride == 1 {
+		// The unknot, or a twisted unknot with a single cross.
+		return poly.Int64P{term(1, 0)}
+	}
+
+	return normalizeAlexander(m.Minor(0, 0).Det())
+}
+
+// AlexanderMatrix generates the matrix for calculating the Alexander polynomial of the Knot.
+// Rows correspond to crosses and columns to arcs, both in order of linkage.
+// Each row contains the terms 1 - t for the over arc, and t and -1 for the incoming and outgoing arcs.
+// For left-handed crosses, the terms for the incoming and outgoing arcs are swapped.
+func (k *Knot) AlexanderMatrix() *poly.Int64M {
+	crosses := k.Crosses()
+	if len(crosses) == 0 {
+		return nil
+	}
+
+	m := poly.NewInt64M(uint(len(crosses)), uint(len(crosses)))
+	for row, rc := range crosses {
+		in, out := term(1, 1), term(-1, 0)
+		if rc.Handedness == Left {
+			in, out = out, in
+		}
+		for col, cc := range crosses {
+			p := poly.Int64P{}
+			if rc.Over == cc.Out {
+				p = p.Add(poly.Int64P{term(1, 0), term(-1, 1)})
+			}
+			if rc.In == cc.Out {
+				p = p.Add(poly.Int64P{in})
+			}
+			if rc.Out == cc.Out {
+				p = p.Add(poly.Int64P{out})
+			}
+			m.Elements[row*int(m.Stride)+col] = trim(p)
+		}
+	}
+
+	return m
+}
+
+// normalizeAlexander multiplies by ±tᵏ to make the polynomial symmetric, with a positive value at t = 1.
+func normalizeAlexander(p poly.Int64P) poly.Int64P {
+	ret := trim(p)
+	if len(ret) == 1 && ret[0].C == 0 {
+		return ret
+	}
+
+	var sum int64
+	for _, t := range ret {
+		sum += t.C
+	}
+	// Terms are sorted highest-first.
+	hi, lo := ret[0].Ind[0], ret[len(ret)-1].Ind[0]
+	shift := term(1, -lo-(hi-lo)/2)
+	if sum < 0 {
+		shift.C = -1
+	}
+
+	return ret.MulT(shift)
+}
+
+// trim compacts the polynomial and drops zero terms, keeping a single zero term for the zero polynomial.
+func trim(p poly.Int64P) poly.Int64P {
+	ret := poly.Int64P{}
+	for _, t := range p.Compact() {
+		if t.C != 0 {
+			ret = append(ret, t)
+		}
+	}
+	if len(ret) == 0 {
+		return poly.Int64P{term(0, 0)}
+	}
+
+	return ret
+}
+
+// term returns the single-variable term c·tᵉ.
+func term(c, e int64) poly.Int64T {
+	return poly.Int64T{Ind: poly.Ind{e}, C: c}
+}
@@ -0,0 +1,47 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestAlexander(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want string
+	}{
+		{knot.Unknot(), "1"},
+		{knot.Trefoil(), "x - 1 + x¯¹"},
+		{knot.RightTrefoil(), "x - 1 + x¯¹"},
+		{knot.FigureEight(), "-x + 3 - x¯¹"},
+		// Unknot with two opposite twists.
+		{mustParseGaussCode("O1- U2+ O2+ U1-"), "1"},
+		// Trefoil with a twist.
+		{mustParseGaussCode("O1- U2- O3- U4+ O4+ U1- O2- U3-"), "x - 1 + x¯¹"},
+	} {
+		if got, want := row.k.Alexander().String(), row.want; got != want {
+			t.Errorf("#%d: Alexander() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestAlexanderNonPlanar(t *testing.T) {
+	if p := knot.SimpleKnot(5).Alexander(); p != nil {
+		t.Errorf("SimpleKnot(5).Alexander() = %q; want: nil", p)
+	}
+}
+
+func TestAlexanderMatrix(t *testing.T) {
+	if m := knot.Unknot().AlexanderMatrix(); m != nil {
+		t.Errorf("Unknot().AlexanderMatrix() = %v; want: nil", m)
+	}
+
+	want := `
+⎡       x (-x + 1)       -1⎤
+⎢      -1        x (-x + 1)⎥
+⎣(-x + 1)       -1        x⎦`[1:]
+	if got := knot.Trefoil().AlexanderMatrix().String(); got != want {
+		t.Errorf("Trefoil().AlexanderMatrix() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -5,6 +5,9 @@ type Arc struct {
 	// An Arc starts at 'Start' and ends at 'Stop'.
 	// As a special case, the unknot has no start and stop.
 	Start, Stop *Cross
+
+	// Over lists the crosses where the arc goes over, in order from 'Start' to 'Stop'.
+	Over []*Cross
 }
 
 // Prev returns the previous arc in the knot.
@@ -41,4 +44,7 @@ func (a Arc) Touches(other *Arc) bool {
 // Reverse changes the direction of the arc.
 func (a *Arc) reverse() {
 	a.Start, a.Stop = a.Stop, a.Start
+	for i, j := 0, len(a.Over)-1; i < j; i, j = i+1, j-1 {
+		a.Over[i], a.Over[j] = a.Over[j], a.Over[i]
+	}
 }
@@ -0,0 +1,274 @@
+package knot
+
+// A Braid is a word in the generators of the braid group.
+type Braid struct {
+	// Number of strands.
+	Strands int
+	// Word lists the generators in order: i stands for σᵢ and -i for σᵢ⁻¹, where 0 < i < Strands.
+	// In σᵢ, the i-th strand goes over the next one, forming a right-handed cross.
+	Word []int
+}
+
+// Closure creates the knot by closing the braid, connecting the ends of each strand to its start.
+// Crosses are created in the order of the generators. The knot starts with the arc coming out under the last cross
+// passed by the first strand.
+// If the closure has more than one component, or the braid is not valid, nil is returned. See Link() for closing
+// braids into links.
+func (b Braid) Closure() *Knot {
+	l := b.Link()
+	if l == nil || len(l.start) != 1 {
+		return nil
+	}
+
+	return &Knot{start: l.start[0]}
+}
+
+// Link creates the link by closing the braid, connecting the ends of each strand to its start.
+// Crosses are created in the order of the generators. Components are in order of the first strand they contain, and
+// each starts with the arc coming out under the last cross passed by that strand.
+// If the braid is not valid, nil is returned.
+func (b Braid) Link() *Link {
+	if b.Strands < 1 {
+		return nil
+	}
+	for _, g := range b.Word {
+		if g == 0 || abs(g) >= b.Strands {
+			return nil
+		}
+	}
+
+	crosses := make([]*Cross, len(b.Word))
+	for i, g := range b.Word {
+		crosses[i] = &Cross{Handedness: g > 0}
+	}
+
+	// Follow each strand around the braid, until it gets back to the position of the first one.
+	l := Link{}
+	done := make([]bool, b.Strands)
+	for first := range done {
+		if done[first] {
+			continue
+		}
+		cross, over := []int{}, []bool{}
+		for pos := first; !done[pos]; {
+			done[pos] = true
+			for i, g := range b.Word {
+				switch pos {
+				case abs(g) - 1:
+					pos++
+				case abs(g):
+					pos--
+				default:
+					continue
+				}
+				cross, over = append(cross, i), append(over, (pos == abs(g)) == (g > 0))
+			}
+		}
+		l.start = append(l.start, gaussArc(cross, over, crosses))
+	}
+
+	return &l
+}
+
+// Braid returns a braid whose closure is the knot.
+// It uses Vogel's algorithm: as long as there is a face bordered by two Seifert circles going the same way around
+// it, one of them is pushed over the other, adding two crosses. This keeps the number of Seifert circles, and ends
+// with the circles nested inside each other, going the same way. The circles then become the strands of the braid.
+// If the diagram is not planar, the zero value is returned.
+func (k *Knot) Braid() Braid {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return Braid{Strands: 1, Word: []int{}}
+	}
+	if len(d.faces()) != len(d.x)+2 {
+		return Braid{}
+	}
+
+	for d.vogel() {
+	}
+
+	return d.braid()
+}
+
+// vogel performs a Vogel move, if there is one, and reports whether it did.
+func (d *diagram) vogel() bool {
+	circle := map[int]int{}
+	for i, edges := range d.seifert() {
+		for _, e := range edges {
+			circle[e] = i
+		}
+	}
+
+	for _, face := range d.faces() {
+		for n, a := range face {
+			for _, b := range face[n+1:] {
+				ea, eb := d.x[a[0]].e[a[1]], d.x[b[0]].e[b[1]]
+				fa, fb := d.forward(a), d.forward(b)
+				if circle[ea] != circle[eb] && fa == fb {
+					*d = *d.poked(ea, eb, Handedness(fa))
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// forward reports whether the edge at the passed end leaves the cross.
+func (d *diagram) forward(end [2]int) bool {
+	return end[1] == 2 || end[1] == d.x[end[0]].overOut()
+}
+
+// poked returns a copy of the diagram with edge 'a' pushed over edge 'b', across a face bordered by both.
+// Walking around the face, the edges must go the same way: forward if 'h' is Right, backward otherwise.
+// Two crosses are added, first the one reached first along 'a', which has handedness 'h'.
+func (d *diagram) poked(a, b int, h Handedness) *diagram {
+	ret := diagram{
+		x:     append([]pdCross{}, d.x...),
+		loops: d.loops,
+	}
+
+	// Edges 'a' and 'b' keep going out of the same crosses, and new edges continue in to the same crosses.
+	next := 0
+	for _, x := range d.x {
+		for _, e := range x.e {
+			next = max(next, e+1)
+		}
+	}
+	heads := d.heads()
+	a2, a3, b2, b3 := next, next+1, next+2, next+3
+	ret.x[heads[a][0]].e[heads[a][1]] = a3
+	ret.x[heads[b][0]].e[heads[b][1]] = b3
+	ret.x = append(ret.x, newPDCross(b2, b3, a, a2, h), newPDCross(b, b2, a2, a3, !h))
+
+	return &ret
+}
+
+// braid reads the braid off a diagram with nested Seifert circles, all going the same way.
+// Cutting each circle along a line going out from the middle, the crosses along each circle are in the order of the
+// generators.
+func (d *diagram) braid() Braid {
+	circles := d.seifert()
+	circle := map[int]int{}
+	for i, edges := range circles {
+		for _, e := range edges {
+			circle[e] = i
+		}
+	}
+
+	// Crosses connect neighbouring circles. Number them in order, starting with one of the innermost or outermost ones.
+	next := make([]map[int]bool, len(circles))
+	for i := range next {
+		next[i] = map[int]bool{}
+	}
+	for _, x := range d.x {
+		under, over := circle[x.e[0]], circle[x.e[(x.overOut()+2)%4]]
+		next[under][over], next[over][under] = true, true
+	}
+	first := 0
+	for i, n := range next {
+		if len(n) == 1 {
+			first = i
+		}
+	}
+	index := make([]int, len(circles))
+	for i := range index {
+		index[i] = -1
+	}
+	for i, n := first, 0; i >= 0; n++ {
+		index[i] = n
+		prev := i
+		i = -1
+		for j := range next[prev] {
+			if index[j] < 0 {
+				i = j
+			}
+		}
+	}
+
+	// Cut each circle, going out from the face inside (or outside) the first circle.
+	faces := d.faces()
+	twins := d.twins()
+	face := map[[2]int]int{}
+	inner := -1
+	for f, ends := range faces {
+		in := true
+		for _, end := range ends {
+			face[end] = f
+			in = in && index[circle[d.x[end[0]].e[end[1]]]] == 0
+		}
+		if in {
+			inner = f
+		}
+	}
+	cut := make([]int, len(circles))
+	for f, i := inner, 0; i < len(circles); i++ {
+		for _, end := range faces[f] {
+			if e := d.x[end[0]].e[end[1]]; index[circle[e]] == i {
+				cut[i], f = e, face[twins[end]]
+				break
+			}
+		}
+	}
+
+	// Order the crosses so that they are in order along each circle, starting after the cut.
+	heads := d.heads()
+	after := make([][]int, len(d.x))
+	deps := make([]int, len(d.x))
+	for _, edges := range circles {
+		start := 0
+		for n, e := range edges {
+			if e == cut[index[circle[e]]] {
+				start = n
+			}
+		}
+		for n := 1; n < len(edges); n++ {
+			i, j := heads[edges[(start+n-1)%len(edges)]][0], heads[edges[(start+n)%len(edges)]][0]
+			after[i] = append(after[i], j)
+			deps[j]++
+		}
+	}
+	order := []int{}
+	for i := range d.x {
+		if deps[i] == 0 {
+			order = append(order, i)
+		}
+	}
+	for n := 0; n < len(order); n++ {
+		for _, j := range after[order[n]] {
+			if deps[j]--; deps[j] == 0 {
+				order = append(order, j)
+			}
+		}
+	}
+	if len(order) != len(d.x) {
+		panic("knot: should not happen")
+	}
+
+	// In σᵢ, the over-strand comes from the i-th strand and the cross is right-handed. If it comes from the outer
+	// strand instead, the circles are numbered the other way around.
+	word := make([]int, len(order))
+	flip := 0
+	for n, i := range order {
+		x := d.x[i]
+		under, over := index[circle[x.e[0]]], index[circle[x.e[(x.overOut()+2)%4]]]
+		word[n] = min(under, over) + 1
+		if (over < under) != (x.h == Right) {
+			flip++
+		}
+	}
+	for n, i := range order {
+		if flip == len(order) {
+			word[n] = len(circles) - word[n]
+		}
+		if d.x[i].h == Left {
+			word[n] = -word[n]
+		}
+	}
+	if flip != 0 && flip != len(order) {
+		panic("knot: should not happen")
+	}
+
+	return Braid{Strands: len(circles), Word: word}
+}
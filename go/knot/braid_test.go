@@ -0,0 +1,89 @@
+package knot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestBraidClosure(t *testing.T) {
+	for i, row := range []struct {
+		b     knot.Braid
+		size  int
+		jones string
+	}{
+		{knot.Braid{1, nil}, 0, "1"},
+		{knot.Braid{2, []int{1}}, 1, "1"},
+		{knot.Braid{2, []int{1, 1, 1}}, 3, "-x⁴ + x³ + x"},
+		{knot.Braid{2, []int{-1, -1, -1}}, 3, "x¯¹ + x¯³ - x¯⁴"},
+		{knot.Braid{3, []int{1, -2, 1, -2}}, 4, "x² - x + 1 - x¯¹ + x¯²"},
+		{knot.Braid{3, []int{1, 1, 1, 2, -1, 2}}, 6, "-x⁶ + x⁵ - x⁴ + 2x³ - x² + x"},
+	} {
+		k := row.b.Closure()
+		if k == nil {
+			t.Errorf("#%d: %v.Closure() = nil", i+1, row.b)
+			continue
+		}
+		if got, want := k.Size(), row.size; got != want {
+			t.Errorf("#%d: %v.Closure().Size() = %d; want: %d", i+1, row.b, got, want)
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: %v.Closure().Jones() = %q; want: %q", i+1, row.b, got, want)
+		}
+	}
+}
+
+func TestBraidClosureNil(t *testing.T) {
+	for i, b := range []knot.Braid{
+		{0, nil},
+		{2, nil},
+		{2, []int{0}},
+		{2, []int{2}},
+		{3, []int{-3}},
+		// Links.
+		{2, []int{1, 1}},
+		{3, []int{1, 1, 1}},
+	} {
+		if k := b.Closure(); k != nil {
+			t.Errorf("#%d: %v.Closure() = %v; want: nil", i+1, b, k)
+		}
+	}
+}
+
+func TestKnotBraid(t *testing.T) {
+	if got, want := knot.Unknot().Braid(), (knot.Braid{1, []int{}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unknot().Braid() = %v; want: %v", got, want)
+	}
+	if got, want := knot.SimpleKnot(5).Braid(), (knot.Braid{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("SimpleKnot(5).Braid() = %v; want: %v", got, want)
+	}
+
+	for i, code := range [][]int{
+		{4, 6, 2},
+		{4, 6, 8, 2},
+		{6, 8, 10, 2, 4},
+		{4, 8, 10, 2, 6},
+		{4, 8, -12, 2, -14, -16, -6, -10},
+	} {
+		k, err := knot.FromDTCode(code)
+		if err != nil {
+			t.Fatalf("#%d: FromDTCode(%v) returned error: %v", i+1, code, err)
+		}
+		b := k.Braid()
+		c := b.Closure()
+		if c == nil {
+			t.Errorf("#%d: FromDTCode(%v).Braid() = %v has no closure", i+1, code, b)
+			continue
+		}
+		if got, want := c.HOMFLY().String(), k.HOMFLY().String(); got != want {
+			t.Errorf("#%d: FromDTCode(%v).Braid() = %v; HOMFLY() = %q; want: %q", i+1, code, b, got, want)
+		}
+	}
+
+	// Closures of braids are already braided.
+	b := knot.Braid{4, []int{1, -2, 3, 1, -2, 3, -2}}
+	if got := b.Closure().Braid(); got.Strands != b.Strands || len(got.Word) != len(b.Word) {
+		t.Errorf("%v.Closure().Braid() = %v", b, got)
+	}
+}
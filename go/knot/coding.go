@@ -3,6 +3,7 @@ package knot
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Orientation values: East, North, West, South.
@@ -37,7 +38,10 @@ const (
 	MaxDirection
 )
 
-var IncompleteDirections = errors.New("knot: incomplete directions")
+var (
+	IncompleteDirections = errors.New("knot: incomplete directions")
+	InvalidGrid          = errors.New("knot: invalid grid")
+)
 
 // Orientation encodes an absolute directionality.
 type Orientation byte
@@ -69,6 +73,42 @@ func (err InvalidCrossing) Error() string {
 	return fmt.Sprintf("knot: invalid crossing at position %s", Point(err))
 }
 
+// InvalidDirection indicates an unknown character when parsing directions.
+type InvalidDirection struct {
+	// Pos is the position of the character, counting runes from 0.
+	Pos  int
+	Char rune
+}
+
+// Error implements the error interface.
+func (err InvalidDirection) Error() string {
+	return fmt.Sprintf("knot: invalid direction %q at position %d", err.Char, err.Pos)
+}
+
+// ParseDirections parses directions from their compact representation, e.g. "FLUR".
+// For compatibility with the Rust crate, "O" (over) is accepted as an alias for "F".
+func ParseDirections(s string) (Directions, error) {
+	ds := Directions{}
+	pos := 0
+	for _, c := range s {
+		switch c {
+		case 'F', 'O':
+			ds = append(ds, Forward)
+		case 'L':
+			ds = append(ds, TurnLeft)
+		case 'U':
+			ds = append(ds, Under)
+		case 'R':
+			ds = append(ds, TurnRight)
+		default:
+			return nil, InvalidDirection{pos, c}
+		}
+		pos++
+	}
+
+	return ds, nil
+}
+
 // Grid decodes directions into a grid containing cells.
 // It uses (0, 0) as the starting point, and east as the initial orientation.
 func (ds Directions) Grid() (Grid, error) {
@@ -114,6 +154,125 @@ func (ds Directions) Grid() (Grid, error) {
 	return g, nil
 }
 
+// Knot decodes directions into a knot.
+func (ds Directions) Knot() (*Knot, error) {
+	g, err := ds.Grid()
+	if err != nil {
+		return nil, err
+	}
+	return g.Knot()
+}
+
+// Knot walks the path in the grid and links the arcs and crosses along it.
+// Like Directions.Grid(), it uses (0, 0) as the starting point, and east as the initial orientation.
+func (g Grid) Knot() (*Knot, error) {
+	type visit struct {
+		c    *Cross
+		over bool
+	}
+	visits := []visit{}
+	crosses := map[Point]*Cross{}
+	seen := map[Point]int{}
+
+	pos, o := Point{}, E
+	for {
+		cell, ok := g[pos]
+		if !ok {
+			return nil, fmt.Errorf("%w: path leaves the grid at position %s", InvalidGrid, pos)
+		}
+		seen[pos]++
+		if cell.IsCross() {
+			over, under := cell.Strands()
+			if seen[pos] > 2 || (o != over && o != under) {
+				return nil, fmt.Errorf("%w: cannot pass through crossing %s going %s", InvalidCrossing(pos), cell, o)
+			}
+			c, ok := crosses[pos]
+			if !ok {
+				c = &Cross{Handedness: cell.Handedness()}
+				crosses[pos] = c
+			}
+			visits = append(visits, visit{c, o == over})
+		} else {
+			if seen[pos] > 1 || cell.Orientation != o {
+				return nil, fmt.Errorf("%w: cannot pass through cell %s at position %s going %s", InvalidGrid, cell, pos, o)
+			}
+			o = o.Turn(cell.Direction)
+		}
+		pos = pos.Step(o)
+		if pos == (Point{}) && o == E {
+			break
+		}
+	}
+	if len(seen) != len(g) {
+		return nil, fmt.Errorf("%w: %d cells are not on the path", InvalidGrid, len(g)-len(seen))
+	}
+	for pos := range crosses {
+		if seen[pos] != 2 {
+			return nil, fmt.Errorf("%w: crossing %s is only passed once", InvalidCrossing(pos), g[pos])
+		}
+	}
+	if len(visits) == 0 {
+		return Unknot(), nil
+	}
+
+	// Start with the arc coming out from under the last cross.
+	start := 0
+	for i, v := range visits {
+		if !v.over {
+			start = i + 1
+		}
+	}
+	a := &Arc{}
+	k := Knot{start: a}
+	for i := range visits {
+		v := visits[(start+i)%len(visits)]
+		if v.over {
+			v.c.Over = a
+			a.Over = append(a.Over, v.c)
+			continue
+		}
+		a.Stop, v.c.In = v.c, a
+		if i == len(visits)-1 {
+			a = k.start
+		} else {
+			a = &Arc{}
+		}
+		a.Start, v.c.Out = v.c, a
+	}
+
+	return &k, nil
+}
+
+// Strands returns the orientations of the strands going over and under at a cross.
+func (o Orientation) Strands() (over, under Orientation) {
+	switch o.Clamp() {
+	case EN:
+		return E, N
+	case NW:
+		return N, W
+	case WS:
+		return W, S
+	case SE:
+		return S, E
+	case ES:
+		return E, S
+	case NE:
+		return N, E
+	case WN:
+		return W, N
+	case SW:
+		return S, W
+	}
+	panic(fmt.Sprintf("knot: not a cross: %s", o))
+}
+
+// Handedness returns the handedness of a cross.
+// The cross is right-handed if the strand going under goes to the left of the strand going over.
+func (o Orientation) Handedness() Handedness {
+	over, under := o.Strands()
+	return Handedness(under == over.Turn(TurnLeft))
+}
+
 // Base clamps the orientation to its base orientation.
 func (o Orientation) Base() Orientation {
 	return o % MaxBaseOrientation
@@ -232,6 +391,15 @@ func (d Direction) String() string {
 	panic("knot: should not happen")
 }
 
+// String returns the compact representation, which can be parsed by ParseDirections.
+func (ds Directions) String() string {
+	var b strings.Builder
+	for _, d := range ds {
+		b.WriteString(d.String())
+	}
+	return b.String()
+}
+
 // String returns a short, human-readable representation.
 func (c Cell) String() string {
 	return fmt.Sprintf("%s (%s)", c.Orientation, c.Direction)
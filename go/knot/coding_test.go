@@ -1,6 +1,7 @@
 package knot_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -82,3 +83,118 @@ func TestGrid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDirections(t *testing.T) {
+	for i, row := range []struct {
+		s   string
+		dir knot.Directions
+		err error
+	}{
+		{"", knot.Directions{}, nil},
+		{"LLLL", knot.Directions{knot.TurnLeft, knot.TurnLeft, knot.TurnLeft, knot.TurnLeft}, nil},
+		{"FLUR", knot.Directions{knot.Forward, knot.TurnLeft, knot.Under, knot.TurnRight}, nil},
+		{"OLUR", knot.Directions{knot.Forward, knot.TurnLeft, knot.Under, knot.TurnRight}, nil},
+		{"FLX", nil, knot.InvalidDirection{2, 'X'}},
+		{"L→L", nil, knot.InvalidDirection{1, '→'}},
+		{"fl", nil, knot.InvalidDirection{0, 'f'}},
+	} {
+		dir, err := knot.ParseDirections(row.s)
+		if !errors.Is(err, row.err) {
+			t.Errorf("#%d: ParseDirections(%q) error = %v; want: %v", i+1, row.s, err, row.err)
+		}
+		if !reflect.DeepEqual(dir, row.dir) {
+			t.Errorf("#%d: ParseDirections(%q) = %v; want: %v", i+1, row.s, dir, row.dir)
+		}
+	}
+}
+
+func TestDirectionsString(t *testing.T) {
+	for i, s := range []string{
+		"",
+		"LLLL",
+		"FLLLURRR",
+		"LFLFFLLFLFLLUFLF",
+	} {
+		dir, err := knot.ParseDirections(s)
+		if err != nil {
+			t.Errorf("#%d: ParseDirections(%q) returned error: %v", i+1, s, err)
+			continue
+		}
+		if got := dir.String(); got != s {
+			t.Errorf("#%d: ParseDirections(%q).String() = %q", i+1, s, got)
+		}
+	}
+}
+
+func TestDirectionsKnot(t *testing.T) {
+	for i, row := range []struct {
+		s     string
+		knot  string
+		jones string
+	}{
+		{"LLLL", "A1", "1"},
+		{"FLLLURRR", "L1 A1{L1} L1", "1"},
+		{"FRRRULLL", "R1 A1{R1} R1", "1"},
+		{"LFLFFLLFLFLLUFLF", "R1 A1{R3} R2 A2{R1} R3 A3{R2} R1", "-x⁴ + x³ + x"},
+	} {
+		dir, err := knot.ParseDirections(row.s)
+		if err != nil {
+			t.Errorf("#%d: ParseDirections(%q) returned error: %v", i+1, row.s, err)
+			continue
+		}
+		k, err := dir.Knot()
+		if err != nil {
+			t.Errorf("#%d: Knot() returned error: %v", i+1, err)
+			continue
+		}
+		if got, want := k.String(), row.knot; got != want {
+			t.Errorf("#%d: Knot() = %q; want: %q", i+1, got, want)
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: Knot().Jones() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestGridKnotError(t *testing.T) {
+	for i, row := range []struct {
+		grid knot.Grid
+		err  error
+	}{
+		{
+			// Path leaves the grid.
+			grid: knot.Grid{
+				knot.Point{0, 0}: knot.Cell{knot.E, knot.TurnLeft},
+				knot.Point{0, 1}: knot.Cell{knot.N, knot.TurnLeft},
+			},
+			err: knot.InvalidGrid,
+		},
+		{
+			// Extra cell that is not on the path.
+			grid: knot.Grid{
+				knot.Point{0, 0}:  knot.Cell{knot.E, knot.TurnLeft},
+				knot.Point{0, 1}:  knot.Cell{knot.N, knot.TurnLeft},
+				knot.Point{-1, 1}: knot.Cell{knot.W, knot.TurnLeft},
+				knot.Point{-1, 0}: knot.Cell{knot.S, knot.TurnLeft},
+				knot.Point{5, 5}:  knot.Cell{knot.S, knot.TurnLeft},
+			},
+			err: knot.InvalidGrid,
+		},
+		{
+			// Crossing that is only passed once.
+			grid: knot.Grid{
+				knot.Point{0, 0}:  knot.Cell{knot.E, knot.TurnLeft},
+				knot.Point{0, 1}:  knot.Cell{knot.NW, knot.Forward},
+				knot.Point{0, 2}:  knot.Cell{knot.N, knot.TurnLeft},
+				knot.Point{-1, 2}: knot.Cell{knot.W, knot.TurnLeft},
+				knot.Point{-1, 1}: knot.Cell{knot.S, knot.Forward},
+				knot.Point{-1, 0}: knot.Cell{knot.S, knot.TurnLeft},
+			},
+			err: knot.InvalidCrossing{0, 1},
+		},
+	} {
+		if _, err := row.grid.Knot(); !errors.Is(err, row.err) {
+			t.Errorf("#%d: Knot() error = %v; want: %v", i+1, err, row.err)
+		}
+	}
+}
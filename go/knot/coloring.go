@@ -0,0 +1,95 @@
+package knot
+
+// Colorings returns every Fox n-coloring of the knot, i.e. every assignment of colors 0 to n-1 to the arcs, in order,
+// where twice the color of the arc going over each cross equals the sum of the colors of the arcs going in and out,
+// modulo n. This is the relation encoded by Matrix(), reduced modulo n.
+// Constant colorings are included, so there are always at least n of them.
+func (k *Knot) Colorings(n int) [][]int {
+	return k.Link().Colorings(n)
+}
+
+// IsColorable reports whether the knot has a Fox n-coloring that uses more than one color.
+// For prime n, this is the case if and only if n divides the determinant.
+func (k *Knot) IsColorable(n int) bool {
+	return k.Link().IsColorable(n)
+}
+
+// Colorings returns every Fox n-coloring of the link, with colors assigned to the arcs in order, see
+// Knot.Colorings().
+func (l *Link) Colorings(n int) [][]int {
+	ret := [][]int{}
+	l.colorings(n, false, func(colors []int) bool {
+		ret = append(ret, append([]int{}, colors...))
+		return true
+	})
+
+	return ret
+}
+
+// IsColorable reports whether the link has a Fox n-coloring that uses more than one color.
+func (l *Link) IsColorable(n int) bool {
+	found := false
+	// Adding the same value to each color results in another coloring, so the first color can be fixed to 0.
+	l.colorings(n, true, func(colors []int) bool {
+		for _, c := range colors {
+			if c != 0 {
+				found = true
+			}
+		}
+		return !found
+	})
+
+	return found
+}
+
+// colorings passes every Fox n-coloring of the link to 'fn', until it returns false.
+// Arcs are colored in order. The color of an arc is determined by the arcs going in to and over its starting cross,
+// if those are already colored; otherwise each color is tried. If 'zero' is set, the first arc is colored 0.
+func (l *Link) colorings(n int, zero bool, fn func([]int) bool) {
+	if n < 1 {
+		return
+	}
+
+	arcs := l.Arcs()
+	index := map[*Arc]int{}
+	for i, a := range arcs {
+		index[a] = i
+	}
+	// Each cross is checked as soon as its arcs are colored.
+	checks := make([][]*Cross, len(arcs))
+	for _, c := range l.Crosses() {
+		last := max(index[c.In], index[c.Out], index[c.Over])
+		checks[last] = append(checks[last], c)
+	}
+
+	colors := make([]int, len(arcs))
+	var color func(i int) bool
+	color = func(i int) bool {
+		if i == len(arcs) {
+			return fn(colors)
+		}
+
+		try := func(x int) bool {
+			colors[i] = x
+			for _, c := range checks[i] {
+				if (2*colors[index[c.Over]]-colors[index[c.In]]-colors[index[c.Out]])%n != 0 {
+					return true
+				}
+			}
+			return color(i + 1)
+		}
+		if c := arcs[i].Start; c != nil && index[c.In] < i && index[c.Over] < i {
+			return try(((2*colors[index[c.Over]]-colors[index[c.In]])%n + n) % n)
+		}
+		if i == 0 && zero {
+			return try(0)
+		}
+		for x := 0; x < n; x++ {
+			if !try(x) {
+				return false
+			}
+		}
+		return true
+	}
+	color(0)
+}
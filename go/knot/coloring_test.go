@@ -0,0 +1,95 @@
+package knot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestColorings(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		n    int
+		want [][]int
+	}{
+		{knot.Unknot(), 2, [][]int{{0}, {1}}},
+		{knot.Trefoil(), 2, [][]int{{0, 0, 0}, {1, 1, 1}}},
+		{knot.Trefoil(), 3, [][]int{
+			{0, 0, 0}, {0, 1, 2}, {0, 2, 1},
+			{1, 0, 2}, {1, 1, 1}, {1, 2, 0},
+			{2, 0, 1}, {2, 1, 0}, {2, 2, 2},
+		}},
+		{knot.Trefoil(), 0, [][]int{}},
+	} {
+		if got := row.k.Colorings(row.n); !reflect.DeepEqual(got, row.want) {
+			t.Errorf("#%d: Colorings(%d) = %v; want: %v", i+1, row.n, got, row.want)
+		}
+	}
+}
+
+func TestColoringsCount(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		n    int
+		want int
+	}{
+		{knot.Trefoil(), 5, 5},
+		{knot.Trefoil(), 6, 18},
+		{knot.Trefoil(), 9, 27},
+		{knot.FigureEight(), 5, 25},
+		{knot.SimpleKnot(6), 21, 441},
+		{knot.Braid{3, []int{1, -2, 1, -2}}.Closure(), 5, 25},
+	} {
+		if got := len(row.k.Colorings(row.n)); got != row.want {
+			t.Errorf("#%d: len(Colorings(%d)) = %d; want: %d", i+1, row.n, got, row.want)
+		}
+	}
+}
+
+func TestIsColorable(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		n    int
+		want bool
+	}{
+		{knot.Unknot(), 3, false},
+		{knot.Trefoil(), 3, true},
+		{knot.Trefoil(), 5, false},
+		{knot.Trefoil(), 6, true},
+		{knot.FigureEight(), 3, false},
+		{knot.FigureEight(), 5, true},
+		{knot.SimpleKnot(5), 11, true},
+		{knot.SimpleKnot(6), 3, true},
+		{knot.SimpleKnot(6), 7, true},
+		{knot.SimpleKnot(6), 5, false},
+	} {
+		if got := row.k.IsColorable(row.n); got != row.want {
+			t.Errorf("#%d: IsColorable(%d) = %v; want: %v", i+1, row.n, got, row.want)
+		}
+	}
+
+	// Links.
+	if !knot.HopfLink().IsColorable(2) {
+		t.Errorf("HopfLink().IsColorable(2) = false; want: true")
+	}
+	if knot.HopfLink().IsColorable(3) {
+		t.Errorf("HopfLink().IsColorable(3) = true; want: false")
+	}
+}
+
+func TestIsColorableDet(t *testing.T) {
+	for i, k := range []*knot.Knot{
+		knot.Trefoil(),
+		knot.SimpleKnot(5),
+		knot.SimpleKnot(7),
+		knot.Braid{3, []int{1, 1, 1, 2, -1, 2}}.Closure(),
+	} {
+		det := k.Det()
+		for _, p := range []int{2, 3, 5, 7, 11, 13, 43} {
+			if got, want := k.IsColorable(p), det%uint64(p) == 0; got != want {
+				t.Errorf("#%d: IsColorable(%d) = %v; want: %v (det = %d)", i+1, p, got, want, det)
+			}
+		}
+	}
+}
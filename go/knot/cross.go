@@ -45,6 +45,22 @@ func (c Cross) Right() *Arc {
 	return c.Out
 }
 
+// slot returns the position of one of the four arc ends around the cross, counter-clockwise, starting with the
+// incoming arc (0). The outgoing arc is always opposite (2), while the position of the arc going over depends on the
+// handedness.
+func (c Cross) slot(over, out bool) int {
+	switch {
+	case !over && !out:
+		return 0
+	case !over && out:
+		return 2
+	case out == (c.Handedness == Right):
+		return 1
+	}
+
+	return 3
+}
+
 // Reverse swaps the in- and out-going arcs. Handedness is not changed.
 func (c *Cross) reverse() {
 	c.In, c.Out = c.Out, c.In
@@ -1,52 +1,39 @@
 package knot
 
 import (
-	"fmt"
-
 	"github.com/attilaolah/math/go/poly"
 )
 
 // Det calculates the Knot's determinant.
 func (k *Knot) Det() uint64 {
-	m := k.Matrix()
-	if m == nil {
-		return 1
-	}
-
-	det := k.Matrix().AnyMinor().Det()
-	if len(det) != 1 {
-		panic(fmt.Sprintf("knot: unexpected determinant %s", det))
-	}
-	c := det[0].C
-	if c < 0 {
-		return uint64(-c)
-	}
-	return uint64(c)
+	return k.Link().Det()
 }
 
 // Matrix generates the matrix for calculating determinant of the Knot.
+// It is the same as the matrix of the knot as a link, see Link.Matrix().
 func (k *Knot) Matrix() *poly.Int64M {
-	crosses := k.Crosses()
-	if len(crosses) == 0 {
-		return nil
+	return k.Link().Matrix()
+}
+
+// DoubleCoverHomology returns the invariant factors of the first homology group of the double branched cover of the
+// knot, i.e. the orders of the cyclic groups it is the direct sum of, each dividing the next.
+// The group is presented by any first minor of Matrix(), and its order is the determinant.
+func (k *Knot) DoubleCoverHomology() []uint64 {
+	ret := []uint64{}
+	m := k.Matrix()
+	if m == nil {
+		return ret
 	}
 
-	m := poly.NewInt64M(uint(len(crosses)), uint(len(crosses)))
-	for row, rc := range crosses {
-		for col, cc := range crosses {
-			var f int64
-			if rc.In == cc.Out {
-				f -= 1
-			}
-			if rc.Out == cc.Out {
-				f -= 1
-			}
-			if rc.Over == cc.Out {
-				f += 2
-			}
-			m.Elements[row*int(m.Stride)+col][0].C = f
+	d, _, _, err := m.Minor(0, 0).SmithNormalForm()
+	if err != nil {
+		panic(err)
+	}
+	for _, c := range d {
+		if c != 1 {
+			ret = append(ret, uint64(c))
 		}
 	}
 
-	return m
+	return ret
 }
@@ -1,6 +1,7 @@
 package knot_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/attilaolah/math/go/knot"
@@ -23,3 +24,27 @@ func TestDet(t *testing.T) {
 		}
 	}
 }
+
+func TestDoubleCoverHomology(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want []uint64
+	}{
+		{knot.Unknot(), []uint64{}},
+		{knot.Trefoil(), []uint64{3}},
+		{knot.FigureEight(), []uint64{5}},
+		{knot.SimpleKnot(6), []uint64{21}},
+		// 6₁
+		{knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure(), []uint64{9}},
+		// 8₁₈
+		{knot.Braid{3, []int{1, -2, 1, -2, 1, -2, 1, -2}}.Closure(), []uint64{3, 15}},
+		// 4₁ # 6₁, which has the same determinant as 8₁₈.
+		{knot.Braid{6, []int{1, -2, 1, -2, 3, 3, 4, -3, -5, 4, -5}}.Closure(), []uint64{45}},
+		// 3₁ # 3₁
+		{knot.Braid{3, []int{1, 1, 1, 2, 2, 2}}.Closure(), []uint64{3, 3}},
+	} {
+		if got := row.k.DoubleCoverHomology(); !reflect.DeepEqual(got, row.want) {
+			t.Errorf("#%d: DoubleCoverHomology() = %v; want: %v", i+1, got, row.want)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package knot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// diagram is a planar diagram (PD) representation of a knot or link.
+// Edges are numbered in order of linkage, component by component. Each cross lists its four edges counter-clockwise,
+// starting with the incoming under-edge, which makes the outgoing under-edge the third one.
+type diagram struct {
+	x []pdCross
+	// Number of components that have no crosses at all.
+	loops int
+}
+
+// A pdCross is a single cross in a planar diagram.
+type pdCross struct {
+	e [4]int
+	h Handedness
+}
+
+// diagram builds the planar diagram of the knot.
+// Crosses in the diagram are in the same order as in Crosses().
+func (k Knot) diagram() *diagram {
+	crosses := k.Crosses()
+	if len(crosses) == 0 {
+		return &diagram{loops: 1}
+	}
+
+	index := map[*Cross]int{}
+	for i, c := range crosses {
+		index[c] = i
+	}
+
+	// Collect the edges going in and out of each cross, under (0) and over (1).
+	in, out := make([][2]int, len(crosses)), make([][2]int, len(crosses))
+	seen := make([][2]bool, len(crosses))
+	e := 0
+	visit := func(c *Cross, over int) {
+		i, ok := index[c]
+		if !ok || seen[i][over] {
+			panic(fmt.Sprintf("knot: inconsistent crosses in %s", k))
+		}
+		seen[i][over] = true
+		in[i][over], out[i][over] = e, e+1
+		e++
+	}
+	for _, a := range k.Arcs() {
+		for _, c := range a.Over {
+			if c.Over != a {
+				panic(fmt.Sprintf("knot: inconsistent crosses in %s", k))
+			}
+			visit(c, 1)
+		}
+		visit(a.Stop, 0)
+	}
+
+	d := diagram{x: make([]pdCross, len(crosses))}
+	for i, c := range crosses {
+		if !seen[i][1] {
+			panic(fmt.Sprintf("knot: inconsistent crosses in %s", k))
+		}
+		d.x[i] = newPDCross(in[i][0], out[i][0]%e, in[i][1], out[i][1]%e, c.Handedness)
+	}
+
+	return &d
+}
+
+// newPDCross creates a cross given its under and over edges.
+func newPDCross(underIn, underOut, overIn, overOut int, h Handedness) pdCross {
+	if h == Right {
+		return pdCross{[4]int{underIn, overOut, underOut, overIn}, h}
+	}
+
+	return pdCross{[4]int{underIn, overIn, underOut, overOut}, h}
+}
+
+// edges returns the edges going in and out of the cross, under and over.
+func (x pdCross) edges() (underIn, underOut, overIn, overOut int) {
+	if x.h == Right {
+		return x.e[0], x.e[2], x.e[3], x.e[1]
+	}
+
+	return x.e[0], x.e[2], x.e[1], x.e[3]
+}
+
+// overOut returns the position of the outgoing over-edge around the cross.
+func (x pdCross) overOut() int {
+	if x.h == Right {
+		return 1
+	}
+
+	return 3
+}
+
+// heads maps each edge to the cross where it ends, and the position of the edge around that cross.
+func (d *diagram) heads() map[int][2]int {
+	ret := map[int][2]int{}
+	for i, x := range d.x {
+		ret[x.e[0]] = [2]int{i, 0}
+		if x.h == Right {
+			ret[x.e[3]] = [2]int{i, 3}
+		} else {
+			ret[x.e[1]] = [2]int{i, 1}
+		}
+	}
+
+	return ret
+}
+
+// walk returns the edges of each component with at least one cross, in order of linkage.
+// Components start at their lowest edge, and are sorted by it.
+func (d *diagram) walk() [][]int {
+	heads := d.heads()
+	edges := make([]int, 0, len(heads))
+	for e := range heads {
+		edges = append(edges, e)
+	}
+	sort.Ints(edges)
+
+	ret := [][]int{}
+	seen := map[int]bool{}
+	for _, start := range edges {
+		if seen[start] {
+			continue
+		}
+		component := []int{}
+		for e := start; !seen[e]; {
+			seen[e] = true
+			component = append(component, e)
+			h := heads[e]
+			e = d.x[h[0]].e[(h[1]+2)%4]
+		}
+		ret = append(ret, component)
+	}
+
+	return ret
+}
+
+// twins maps each end of an edge, given as a cross and the position of the edge around it, to the other end.
+func (d *diagram) twins() map[[2]int][2]int {
+	ends := map[int][][2]int{}
+	for i, x := range d.x {
+		for j, e := range x.e {
+			ends[e] = append(ends[e], [2]int{i, j})
+		}
+	}
+	ret := map[[2]int][2]int{}
+	for _, end := range ends {
+		ret[end[0]], ret[end[1]] = end[1], end[0]
+	}
+
+	return ret
+}
+
+// faces returns the faces of the diagram, walking around each with the face on the left.
+// Each face is a list of edge ends, given as a cross and the position of the edge leaving the cross along the face.
+// The diagram is planar if and only if each component with crosses adds two more faces than crosses.
+func (d *diagram) faces() [][][2]int {
+	twins := d.twins()
+	ret := [][][2]int{}
+	seen := map[[2]int]bool{}
+	for i := range d.x {
+		for j := 0; j < 4; j++ {
+			face := [][2]int{}
+			for end := [2]int{i, j}; !seen[end]; {
+				seen[end] = true
+				face = append(face, end)
+				// At the other end, the face continues with the next edge clockwise.
+				end = twins[end]
+				end[1] = (end[1] + 3) % 4
+			}
+			if len(face) > 0 {
+				ret = append(ret, face)
+			}
+		}
+	}
+
+	return ret
+}
+
+// seifert returns the Seifert circles of the diagram, i.e. the loops left after smoothing each cross along the
+// orientation of the strands, as lists of edges in order.
+// Circles start at their lowest edge, and are sorted by it.
+func (d *diagram) seifert() [][]int {
+	heads := d.heads()
+	edges := make([]int, 0, len(heads))
+	for e := range heads {
+		edges = append(edges, e)
+	}
+	sort.Ints(edges)
+
+	ret := [][]int{}
+	seen := map[int]bool{}
+	for _, start := range edges {
+		if seen[start] {
+			continue
+		}
+		circle := []int{}
+		for e := start; !seen[e]; {
+			seen[e] = true
+			circle = append(circle, e)
+			// Going in under, continue along the outgoing over-edge, and vice versa.
+			h := heads[e]
+			if x := d.x[h[0]]; h[1] == 0 {
+				e = x.e[x.overOut()]
+			} else {
+				e = x.e[2]
+			}
+		}
+		ret = append(ret, circle)
+	}
+
+	return ret
+}
+
+// components returns the number of components in the diagram.
+func (d *diagram) components() int {
+	return len(d.walk()) + d.loops
+}
+
+// without returns a copy of the diagram with the i-th cross removed.
+// Its strands are reconnected by joining each pair of edges, going in to and out of the cross.
+func (d *diagram) without(i int, pairs ...[2]int) *diagram {
+	ret := diagram{
+		x:     append(append([]pdCross{}, d.x[:i]...), d.x[i+1:]...),
+		loops: d.loops,
+	}
+	for n, p := range pairs {
+		in, out := p[0], p[1]
+		if in == out {
+			// The strand closed up into a loop with no crosses.
+			ret.loops++
+			continue
+		}
+		for j := range ret.x {
+			for k, e := range ret.x[j].e {
+				if e == out {
+					ret.x[j].e[k] = in
+				}
+			}
+		}
+		for j := range pairs[n+1:] {
+			for k, e := range pairs[n+1+j] {
+				if e == out {
+					pairs[n+1+j][k] = in
+				}
+			}
+		}
+	}
+
+	return &ret
+}
+
+// switched returns a copy of the diagram with the over and under strands swapped at the i-th cross.
+func (d *diagram) switched(i int) *diagram {
+	ret := diagram{
+		x:     append([]pdCross{}, d.x...),
+		loops: d.loops,
+	}
+	in, out, overIn, overOut := d.x[i].edges()
+	ret.x[i] = newPDCross(overIn, overOut, in, out, !d.x[i].h)
+
+	return &ret
+}
+
+// smoothed returns a copy of the diagram with the i-th cross smoothed along the orientation of the strands.
+func (d *diagram) smoothed(i int) *diagram {
+	in, out, overIn, overOut := d.x[i].edges()
+	return d.without(i, [2]int{in, overOut}, [2]int{overIn, out})
+}
+
+// untwisted returns a copy of the diagram with all twists undone, i.e. crosses where a strand crosses itself
+// without crossing anything else in between.
+func (d *diagram) untwisted() *diagram {
+	for i, x := range d.x {
+		in, out, overIn, overOut := x.edges()
+		if out == overIn {
+			return d.without(i, [2]int{in, overOut}).untwisted()
+		}
+		if overOut == in {
+			return d.without(i, [2]int{overIn, out}).untwisted()
+		}
+	}
+
+	return d
+}
+
+// key returns a string that is the same for diagrams that only differ in the labelling of edges and crosses.
+// It tries every edge as a starting point, relabels the edges by walking along the components, and picks the
+// lowest result.
+func (d *diagram) key() string {
+	heads := d.heads()
+	best := ""
+	for start := range heads {
+		labels := map[int]int{}
+		visits := []int{}
+		next := start
+		for next >= 0 {
+			for e := next; ; {
+				if _, ok := labels[e]; ok {
+					break
+				}
+				labels[e] = len(labels)
+				h := heads[e]
+				visits = append(visits, h[0])
+				e = d.x[h[0]].e[(h[1]+2)%4]
+			}
+
+			// Continue with an unlabelled strand through the first cross visited, if any.
+			next = -1
+			for _, i := range visits {
+				in, _, overIn, _ := d.x[i].edges()
+				if _, ok := labels[in]; !ok {
+					next = in
+				} else if _, ok := labels[overIn]; !ok {
+					next = overIn
+				}
+				if next >= 0 {
+					break
+				}
+			}
+			if next < 0 && len(labels) < len(heads) {
+				// Disconnected diagram, continue with the lowest unlabelled edge.
+				for e := range heads {
+					if _, ok := labels[e]; !ok && (next < 0 || e < next) {
+						next = e
+					}
+				}
+			}
+		}
+
+		parts := make([]string, len(d.x))
+		for i, x := range d.x {
+			parts[i] = fmt.Sprintf("%d,%d,%d,%d%s", labels[x.e[0]], labels[x.e[1]], labels[x.e[2]], labels[x.e[3]], x.h)
+		}
+		sort.Strings(parts)
+		if s := fmt.Sprintf("%d:%s", d.loops, strings.Join(parts, ";")); best == "" || s < best {
+			best = s
+		}
+	}
+	if best == "" {
+		best = fmt.Sprintf("%d:", d.loops)
+	}
+
+	return best
+}
@@ -0,0 +1,86 @@
+package knot
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidDTCode indicates a malformed Dowker–Thistlethwaite code.
+var InvalidDTCode = errors.New("knot: invalid DT code")
+
+// DTCode returns the Dowker–Thistlethwaite code of the knot.
+// Passing through the crosses is numbered starting with 1, walking from the start of the first arc. Each cross is
+// passed twice, once at an odd and once at an even position. The code lists the even positions for the odd positions
+// 1, 3, 5, etc., with a negative sign if the arc goes over at the even position.
+// If the diagram is not planar, nil is returned.
+func (k *Knot) DTCode() []int {
+	if d := k.diagram(); len(d.faces()) != len(d.x)+2 && len(d.x) > 0 {
+		return nil
+	}
+
+	pos := map[*Cross][]int{}
+	n := 0
+	for _, a := range k.Arcs() {
+		for _, c := range a.Over {
+			n++
+			pos[c] = append(pos[c], -n)
+		}
+		if a.Stop != nil {
+			n++
+			pos[a.Stop] = append(pos[a.Stop], n)
+		}
+	}
+
+	ret := make([]int, n/2)
+	for _, p := range pos {
+		// In planar diagrams, each cross is passed once at an odd and once at an even position.
+		odd, even := p[0], p[1]
+		if abs(odd)%2 == 0 {
+			odd, even = even, odd
+		}
+		// Over passes are already negative.
+		ret[abs(odd)/2] = even
+	}
+
+	return ret
+}
+
+// FromDTCode creates a knot from its Dowker–Thistlethwaite code, as returned by Knot.DTCode().
+// The code does not specify the handedness of crosses, which is found so that the diagram is planar. If this is not
+// possible, NonPlanar is returned.
+// Mirror images have the same code. Of the two, the one where the first cross is left-handed is returned, see
+// planarKnot(). For chiral knots, this can be the mirror image of the knot listed by KnotInfo for the same code: 4 6 2
+// gives the left-handed trefoil, while 3₁ in KnotInfo is right-handed. Flipping the handedness of every cross gives
+// the other one.
+func FromDTCode(code []int) (*Knot, error) {
+	n := len(code)
+	if n == 0 {
+		return Unknot(), nil
+	}
+
+	// For each position, the cross and whether it goes over.
+	cross, over := make([]int, 2*n), make([]bool, 2*n)
+	for i, even := range code {
+		e := abs(even)
+		if e%2 != 0 || e < 2 || e > 2*n {
+			return nil, fmt.Errorf("%w: %d is not an even position between 2 and %d", InvalidDTCode, even, 2*n)
+		}
+		if cross[e-1] != 0 {
+			return nil, fmt.Errorf("%w: position %d is listed more than once", InvalidDTCode, e)
+		}
+		cross[2*i], cross[e-1] = i+1, i+1
+		over[2*i], over[e-1] = even > 0, even < 0
+	}
+	for i := range cross {
+		cross[i]--
+	}
+
+	return planarKnot(cross, over)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,109 @@
+package knot_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestDTCode(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want []int
+	}{
+		{knot.Unknot(), []int{}},
+		{knot.Trefoil(), []int{4, 6, 2}},
+		{knot.SimpleKnot(5), nil},
+		{knot.FigureEight(), []int{6, 8, 2, 4}},
+		{mustParseGaussCode("O1- U1-"), []int{2}},
+	} {
+		if got, want := row.k.DTCode(), row.want; !reflect.DeepEqual(got, want) {
+			t.Errorf("#%d: DTCode() = %v; want: %v", i+1, got, want)
+		}
+	}
+}
+
+func TestFromDTCode(t *testing.T) {
+	for i, row := range []struct {
+		code      []int
+		alexander string
+		jones     string
+	}{
+		{[]int{}, "1", "1"},
+		{[]int{2}, "1", "1"},
+		{[]int{4, 2}, "1", "1"},
+		{[]int{4, 6, 2}, "x - 1 + x¯¹", "x¯¹ + x¯³ - x¯⁴"},
+		{[]int{4, 6, 8, 2}, "-x + 3 - x¯¹", "x² - x + 1 - x¯¹ + x¯²"},
+		{[]int{6, 8, 10, 2, 4}, "x² - x + 1 - x¯¹ + x¯²", "x¯² + x¯⁴ - x¯⁵ + x¯⁶ - x¯⁷"},
+		{[]int{4, 8, 10, 2, 6}, "2x - 3 + 2x¯¹", "x¯¹ - x¯² + 2x¯³ - x¯⁴ + x¯⁵ - x¯⁶"},
+		// 8₁₉, the first non-alternating knot.
+		{[]int{4, 8, -12, 2, -14, -16, -6, -10}, "x³ - x² + 1 - x¯² + x¯³", "x¯³ + x¯⁵ - x¯⁸"},
+	} {
+		k, err := knot.FromDTCode(row.code)
+		if err != nil {
+			t.Errorf("#%d: FromDTCode(%v) returned error: %v", i+1, row.code, err)
+			continue
+		}
+		if got, want := k.Size(), len(row.code); got != want {
+			t.Errorf("#%d: FromDTCode(%v).Size() = %d; want: %d", i+1, row.code, got, want)
+		}
+		if got, want := k.Alexander().String(), row.alexander; got != want {
+			t.Errorf("#%d: FromDTCode(%v).Alexander() = %q; want: %q", i+1, row.code, got, want)
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: FromDTCode(%v).Jones() = %q; want: %q", i+1, row.code, got, want)
+		}
+		if got, want := k.DTCode(), row.code; len(want) > 0 && want[len(want)-1] > 0 && !reflect.DeepEqual(got, want) {
+			// Codes ending with an under pass start at the same position.
+			t.Errorf("#%d: FromDTCode(%v).DTCode() = %v", i+1, row.code, got)
+		}
+	}
+}
+
+func TestFromDTCodeMirror(t *testing.T) {
+	// Chiral knots come back as the mirror image of the ones listed by KnotInfo, with the Jones polynomial V(t⁻¹).
+	for i, row := range []struct {
+		code  []int
+		jones string
+	}{
+		// 3₁: t + t³ - t⁴
+		{[]int{4, 6, 2}, "-x⁴ + x³ + x"},
+		// 5₁: t² + t⁴ - t⁵ + t⁶ - t⁷
+		{[]int{6, 8, 10, 2, 4}, "-x⁷ + x⁶ - x⁵ + x⁴ + x²"},
+		// 5₂: t - t² + 2t³ - t⁴ + t⁵ - t⁶
+		{[]int{4, 8, 10, 2, 6}, "-x⁶ + x⁵ - x⁴ + 2x³ - x² + x"},
+		// 8₁₉: t³ + t⁵ - t⁸
+		{[]int{4, 8, -12, 2, -14, -16, -6, -10}, "-x⁸ + x⁵ + x³"},
+	} {
+		k, err := knot.FromDTCode(row.code)
+		if err != nil {
+			t.Errorf("#%d: FromDTCode(%v) returned error: %v", i+1, row.code, err)
+			continue
+		}
+		for _, c := range k.Crosses() {
+			c.Handedness = !c.Handedness
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: mirror of FromDTCode(%v): Jones() = %q; want: %q", i+1, row.code, got, want)
+		}
+	}
+}
+
+func TestFromDTCodeError(t *testing.T) {
+	for i, row := range []struct {
+		code []int
+		err  error
+	}{
+		{[]int{3}, knot.InvalidDTCode},
+		{[]int{4}, knot.InvalidDTCode},
+		{[]int{0, 2}, knot.InvalidDTCode},
+		{[]int{4, -4}, knot.InvalidDTCode},
+		{[]int{4, 10, 8, 2, 6}, knot.NonPlanar},
+	} {
+		if _, err := knot.FromDTCode(row.code); !errors.Is(err, row.err) {
+			t.Errorf("#%d: FromDTCode(%v) error = %v; want: %v", i+1, row.code, err, row.err)
+		}
+	}
+}
@@ -0,0 +1,408 @@
+package knot
+
+import (
+	"errors"
+)
+
+// NonPlanar indicates a diagram that cannot be drawn without adding crosses.
+var NonPlanar = errors.New("knot: diagram is not planar")
+
+// Directions embeds the knot in the grid, and returns the directions that decode back into the same diagram.
+// The diagram is drawn orthogonally: bends are distributed between the faces using a flow, faces are refined into
+// rectangles and the drawing is compacted. Decoding the directions results in the same diagram, up to the choice of
+// the starting arc.
+func (k *Knot) Directions() (Directions, error) {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return Directions{TurnLeft, TurnLeft, TurnLeft, TurnLeft}, nil
+	}
+
+	faces := d.faces()
+	if len(faces) != len(d.x)+2 {
+		return nil, NonPlanar
+	}
+
+	g := newOrtho(d, faces)
+	g.rectangulate()
+
+	return g.directions(g.compact(2)), nil
+}
+
+// ortho is an orthogonal representation of a planar graph, i.e. a planar graph where each edge goes in one of four
+// orientations, and each vertex has at most one edge going in each orientation.
+// Edges are stored as pairs of darts. Vertices starting with 0 correspond to the crosses in the diagram, followed by
+// bends, and vertices and edges used only for drawing.
+type ortho struct {
+	// Dart 'i' leaves vertex 'from[i]' going towards 'dir[i]'. Its twin is the opposite dart of the same edge.
+	from, twin []int
+	dir        []Orientation
+	// Darts along the knot, as opposed to the ones used only for refining faces.
+	path []bool
+	// out[v][o] is the dart leaving vertex 'v' going towards 'o', or -1 if there is none.
+	out [][4]int
+	// The i-th cross is rotated so that the edge at the j-th position in the diagram goes towards rot[i]+j.
+	rot []Orientation
+}
+
+// newOrtho creates an orthogonal representation of a planar diagram.
+// Each cross has four right angles, so the number of bends needed around each face is fixed. Bends are distributed
+// by pushing them along the shortest paths between the faces, the face with the most edges becoming the outer face.
+func newOrtho(d *diagram, faces [][][2]int) *ortho {
+	twins := d.twins()
+	dart := func(end [2]int) int {
+		return 4*end[0] + end[1]
+	}
+
+	// Walking around an inner face with the face on the left, the turns add up to four left turns, and to four right
+	// turns around the outer face. Each cross makes a left turn, the rest are bends.
+	face := make([]int, 4*len(d.x))
+	supply := make([]int, len(faces))
+	outer := 0
+	for f, ends := range faces {
+		for _, end := range ends {
+			face[dart(end)] = f
+		}
+		supply[f] = 4 - len(ends)
+		if len(ends) > len(faces[outer]) {
+			outer = f
+		}
+	}
+	supply[outer] -= 8
+
+	// bends[i] is the number of left turns along dart 'i' (negative for right turns).
+	bends := make([]int, 4*len(d.x))
+	for f := range faces {
+		for supply[f] > 0 {
+			// Find the nearest face that needs more right turns, moving across edges.
+			via := map[int]int{f: -1}
+			queue, g := []int{f}, -1
+			for g < 0 {
+				h := queue[0]
+				queue = queue[1:]
+				for _, end := range faces[h] {
+					next := face[dart(twins[end])]
+					if _, ok := via[next]; ok {
+						continue
+					}
+					via[next] = dart(end)
+					if supply[next] < 0 {
+						g = next
+						break
+					}
+					queue = append(queue, next)
+				}
+			}
+			n := min(supply[f], -supply[g])
+			supply[f] -= n
+			supply[g] += n
+			for h := g; h != f; h = face[via[h]] {
+				bends[via[h]] += n
+				bends[dart(twins[[2]int{via[h] / 4, via[h] % 4}])] -= n
+			}
+		}
+	}
+
+	// Orient each cross so that the bends along each edge connect the matching ends.
+	rot := make([]Orientation, len(d.x))
+	done := make([]bool, len(d.x))
+	done[0] = true
+	for queue := []int{0}; len(queue) > 0; queue = queue[1:] {
+		i := queue[0]
+		for j := 0; j < 4; j++ {
+			t := twins[[2]int{i, j}]
+			if done[t[0]] {
+				continue
+			}
+			done[t[0]] = true
+			rot[t[0]] = turn(rot[i], j+bends[4*i+j]+2-t[1])
+			queue = append(queue, t[0])
+		}
+	}
+
+	g := ortho{rot: rot}
+	for range d.x {
+		g.vertex()
+	}
+	for i := range d.x {
+		for j := 0; j < 4; j++ {
+			t := twins[[2]int{i, j}]
+			if dart(t) < 4*i+j {
+				continue
+			}
+			u, o := i, turn(rot[i], j)
+			for b := bends[4*i+j]; b != 0; {
+				w := g.vertex()
+				g.edge(u, w, o, true)
+				u = w
+				if b > 0 {
+					o, b = turn(o, 1), b-1
+				} else {
+					o, b = turn(o, -1), b+1
+				}
+			}
+			if o != turn(rot[t[0]], t[1]+2) {
+				panic("knot: should not happen")
+			}
+			g.edge(u, t[0], o, true)
+		}
+	}
+
+	return &g
+}
+
+// vertex adds a new vertex.
+func (g *ortho) vertex() int {
+	g.out = append(g.out, [4]int{-1, -1, -1, -1})
+	return len(g.out) - 1
+}
+
+// edge adds a new edge from 'u' to 'v', going towards 'o', and returns the dart leaving 'u'.
+func (g *ortho) edge(u, v int, o Orientation, path bool) int {
+	i := len(g.from)
+	g.from = append(g.from, u, v)
+	g.twin = append(g.twin, i+1, i)
+	g.dir = append(g.dir, o, turn(o, 2))
+	g.path = append(g.path, path, path)
+	if g.out[u][o] >= 0 || g.out[v][turn(o, 2)] >= 0 {
+		panic("knot: should not happen")
+	}
+	g.out[u][o], g.out[v][turn(o, 2)] = i, i+1
+
+	return i
+}
+
+// split adds a new vertex in the middle of the edge of dart 'i', and returns the new vertex.
+func (g *ortho) split(i int) int {
+	t, v := g.twin[i], g.to(i)
+	w := g.vertex()
+	g.out[v][g.dir[t]] = -1
+	g.out[w][g.dir[t]] = t
+	g.from[t] = w
+	g.edge(w, v, g.dir[i], g.path[i])
+
+	return w
+}
+
+// to returns the vertex where dart 'i' arrives.
+func (g *ortho) to(i int) int {
+	return g.from[g.twin[i]]
+}
+
+// next returns the dart that follows dart 'i' around the face on its left.
+func (g *ortho) next(i int) int {
+	v, back := g.to(i), g.dir[g.twin[i]]
+	for k := 1; k < 4; k++ {
+		if j := g.out[v][turn(back, -k)]; j >= 0 {
+			return j
+		}
+	}
+
+	return g.twin[i]
+}
+
+// bend returns the turn at the end of dart 'i', going around the face on its left: 1 (left), 0 or -1 (right).
+func (g *ortho) bend(i int) int {
+	switch turn(g.dir[g.next(i)], -int(g.dir[i])) {
+	case 1:
+		return 1
+	case 3:
+		return -1
+	}
+
+	return 0
+}
+
+// rectangulate refines the orthogonal representation so that all faces become rectangles.
+// A right turn followed by two left turns is cut off by extending the edge before the right turn. This leaves no
+// right turns in inner faces. The outer face is then enclosed in a frame, by extending edges before the remaining
+// right turns. Extended edges are not part of the path.
+func (g *ortho) rectangulate() {
+	for cut := true; cut; {
+		cut = false
+		for i := range g.from {
+			if g.bend(i) >= 0 {
+				continue
+			}
+			j := g.next(i)
+			for g.bend(j) == 0 {
+				j = g.next(j)
+			}
+			if g.bend(j) < 0 {
+				continue
+			}
+			for j = g.next(j); g.bend(j) == 0; {
+				j = g.next(j)
+			}
+			if g.bend(j) < 0 {
+				continue
+			}
+			w := g.split(g.next(j))
+			g.edge(g.to(i), w, g.dir[i], false)
+			cut = true
+		}
+	}
+
+	// Only the outer face can still have right turns, with at most one left turn between them.
+	corners := []int{}
+	for i := range g.from {
+		if g.bend(i) >= 0 {
+			continue
+		}
+		for j := g.next(i); j != i; j = g.next(j) {
+			if g.bend(j) < 0 {
+				corners = append(corners, j)
+			}
+		}
+		corners = append(corners, i)
+		break
+	}
+	frame := make([]int, len(corners))
+	for n, i := range corners {
+		frame[n] = g.vertex()
+		g.edge(g.to(i), frame[n], g.dir[i], false)
+	}
+	for n, i := range corners {
+		m := (n + 1) % len(corners)
+		j := corners[m]
+		switch g.dir[j] {
+		case g.dir[i]:
+			g.edge(frame[m], frame[n], turn(g.dir[i], 1), false)
+		case turn(g.dir[i], -1):
+			c := g.vertex()
+			g.edge(frame[m], c, g.dir[i], false)
+			g.edge(c, frame[n], turn(g.dir[i], 1), false)
+		default:
+			panic("knot: should not happen")
+		}
+	}
+}
+
+// compact assigns coordinates to the vertices of a rectangulated orthogonal representation.
+// Edges are at least 'gap' long.
+func (g *ortho) compact(gap int) []Point {
+	xs, ys := g.coordinates(E, gap), g.coordinates(N, gap)
+	ret := make([]Point, len(g.out))
+	for v := range ret {
+		ret[v] = Point{xs[v], ys[v]}
+	}
+
+	return ret
+}
+
+// coordinates assigns coordinates along one axis, increasing towards 'o'.
+// Vertices connected by perpendicular edges share the same coordinate, and the rest are ordered by longest paths.
+func (g *ortho) coordinates(o Orientation, gap int) []int {
+	class := make([]int, len(g.out))
+	for v := range class {
+		class[v] = v
+	}
+	var find func(int) int
+	find = func(v int) int {
+		if class[v] != v {
+			class[v] = find(class[v])
+		}
+		return class[v]
+	}
+	for i, d := range g.dir {
+		if d%2 != o%2 {
+			class[find(g.from[i])] = find(g.to(i))
+		}
+	}
+
+	edges := make([][]int, len(g.out))
+	deps := make([]int, len(g.out))
+	for i, d := range g.dir {
+		if d == o {
+			u, v := find(g.from[i]), find(g.to(i))
+			edges[u] = append(edges[u], v)
+			deps[v]++
+		}
+	}
+	pos := make([]int, len(g.out))
+	queue := []int{}
+	for v := range class {
+		if find(v) == v && deps[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+	for ; len(queue) > 0; queue = queue[1:] {
+		u := queue[0]
+		for _, v := range edges[u] {
+			pos[v] = max(pos[v], pos[u]+gap)
+			if deps[v]--; deps[v] == 0 {
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	ret := make([]int, len(g.out))
+	for v := range ret {
+		ret[v] = pos[find(v)]
+	}
+
+	return ret
+}
+
+// directions walks along the knot, and returns the directions that draw it at the passed positions.
+// Directions start one step after the first cross, which is not a cross or a bend as long as edges are at least two
+// steps long.
+func (g *ortho) directions(pos []Point) Directions {
+	type step struct {
+		p    Point
+		dart int
+	}
+	cross := map[Point]int{}
+	for i := range g.rot {
+		cross[pos[i]] = i
+	}
+
+	steps := []step{}
+	start := g.out[0][turn(g.rot[0], 2)]
+	for i := start; ; {
+		for p := pos[g.from[i]]; p != pos[g.to(i)]; {
+			p = p.Step(g.dir[i])
+			steps = append(steps, step{p, i})
+		}
+
+		if v := g.to(i); v < len(g.rot) {
+			// Go straight through crosses.
+			i = g.out[v][g.dir[i]]
+		} else {
+			for _, j := range g.out[g.to(i)] {
+				if j >= 0 && j != g.twin[i] && g.path[j] {
+					i = j
+					break
+				}
+			}
+		}
+		if i == start {
+			break
+		}
+	}
+
+	ret := make(Directions, len(steps))
+	seen := map[Point]bool{}
+	for n, s := range steps {
+		next := steps[(n+1)%len(steps)]
+		i, ok := cross[s.p]
+		switch {
+		case ok && !seen[s.p]:
+			ret[n] = Forward
+			seen[s.p] = true
+		case ok && (g.dir[next.dart]-g.rot[i])%2 == 1:
+			// Going over, towards the second or fourth position.
+			ret[n] = Forward
+		case ok:
+			ret[n] = Under
+		default:
+			ret[n] = Direction(turn(g.dir[next.dart], -int(g.dir[s.dart])))
+		}
+	}
+
+	return ret
+}
+
+// turn returns the orientation after turning left 'n' times (or right for negative values).
+func turn(o Orientation, n int) Orientation {
+	return Orientation(((int(o)+n)%4 + 4) % 4)
+}
@@ -0,0 +1,48 @@
+package knot_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestKnotDirections(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		size int
+	}{
+		{knot.Unknot(), 0},
+		{knot.Trefoil(), 3},
+		{knot.RightTrefoil(), 3},
+		{mustParseGaussCode("O1- U1-"), 1},
+		// Unknot with three twists.
+		{mustParseGaussCode("O1+ U2+ O2+ O3- U3- U1+"), 3},
+		// Right-handed trefoil with two opposite twists.
+		{mustParseGaussCode("O1+ U2+ O3+ U4+ O4+ O5- U5- U1+ O2+ U3+"), 5},
+		{knot.FigureEight(), 4},
+	} {
+		dir, err := row.k.Directions()
+		if err != nil {
+			t.Errorf("#%d: Directions() returned error: %v", i+1, err)
+			continue
+		}
+		k, err := dir.Knot()
+		if err != nil {
+			t.Errorf("#%d: Directions() = %q; Knot() returned error: %v", i+1, dir, err)
+			continue
+		}
+		if got, want := k.Size(), row.size; got != want {
+			t.Errorf("#%d: Directions() = %q; Knot().Size() = %d; want: %d", i+1, dir, got, want)
+		}
+		if got, want := k.HOMFLY().String(), row.k.HOMFLY().String(); got != want {
+			t.Errorf("#%d: Directions() = %q; Knot().HOMFLY() = %q; want: %q", i+1, dir, got, want)
+		}
+	}
+}
+
+func TestKnotDirectionsNonPlanar(t *testing.T) {
+	if _, err := knot.SimpleKnot(5).Directions(); !errors.Is(err, knot.NonPlanar) {
+		t.Errorf("SimpleKnot(5).Directions() error = %v; want: %v", err, knot.NonPlanar)
+	}
+}
@@ -0,0 +1,243 @@
+package knot
+
+import (
+	"github.com/attilaolah/math/go/poly"
+)
+
+// An Edge is a part of an arc between two consecutive crosses along the knot.
+type Edge struct {
+	Arc *Arc
+	// Edges of an arc are numbered from 0 to len(Arc.Over): the i-th edge stops at the i-th cross the arc goes over,
+	// the last one at the end of the arc.
+	Index int
+}
+
+// A Side is an edge bounding a face.
+type Side struct {
+	Edge Edge
+	// Left is set if the face is on the left of the edge, looking in the direction of the arc.
+	Left bool
+}
+
+// A Face is a region of the plane bounded by the diagram.
+type Face struct {
+	// Sides of the face, in order, walking around the face with it on the left.
+	Sides []Side
+	// Faces are colored like a checkerboard: the faces on the two sides of each edge have different colors.
+	Black bool
+}
+
+// A TaitEdge is an edge of the Tait graph, connecting the white faces at two opposite corners of a cross.
+type TaitEdge struct {
+	Cross *Cross
+	// Indices of the faces, as returned by Faces(). They are the same for a cross that can be untwisted.
+	From, To int
+	// Sign is +1 if turning the arc going over clockwise sweeps the white faces, and -1 otherwise.
+	Sign int
+}
+
+// Start returns the cross where the edge starts.
+func (e Edge) Start() *Cross {
+	if e.Index == 0 {
+		return e.Arc.Start
+	}
+
+	return e.Arc.Over[e.Index-1]
+}
+
+// Stop returns the cross where the edge stops.
+func (e Edge) Stop() *Cross {
+	if e.Index == len(e.Arc.Over) {
+		return e.Arc.Stop
+	}
+
+	return e.Arc.Over[e.Index]
+}
+
+// has reports whether the side bounds the face.
+func (f Face) has(s Side) bool {
+	for _, x := range f.Sides {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Faces returns the faces of the diagram, with a checkerboard coloring where the first face is white.
+// A diagram with n crosses has n + 2 faces. Decode a Grid using Grid.Knot() to get the faces of its drawing.
+// If the diagram is not planar, NonPlanar is returned.
+func (k *Knot) Faces() ([]Face, error) {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		e := Edge{Arc: k.start}
+		return []Face{{Sides: []Side{{e, true}}}, {Sides: []Side{{e, false}}, Black: true}}, nil
+	}
+
+	faces := d.faces()
+	if len(faces) != len(d.x)+2 {
+		return nil, NonPlanar
+	}
+
+	edges := k.edges()
+	white := d.checkerboard(faces)
+	ret := make([]Face, len(faces))
+	for f, ends := range faces {
+		ret[f].Black = !white[f]
+		for _, end := range ends {
+			// The face is on the left of the edge if it leaves the cross in the direction of the arc.
+			x := d.x[end[0]]
+			ret[f].Sides = append(ret[f].Sides, Side{edges[x.e[end[1]]], end[1] == 2 || end[1] == x.overOut()})
+		}
+	}
+
+	return ret, nil
+}
+
+// TaitGraph returns the edges of the Tait graph, one for each cross, in order. Its vertices are the white faces.
+// If the diagram is not planar, NonPlanar is returned.
+func (k *Knot) TaitGraph() ([]TaitEdge, error) {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return []TaitEdge{}, nil
+	}
+
+	faces := d.faces()
+	if len(faces) != len(d.x)+2 {
+		return nil, NonPlanar
+	}
+
+	ret := d.tait(faces, d.checkerboard(faces))
+	for i, c := range k.Crosses() {
+		ret[i].Cross = c
+	}
+
+	return ret, nil
+}
+
+// GoeritzMatrix generates the Goeritz matrix of the diagram.
+// Rows and columns correspond to the white faces, in order, leaving out the first face. Off-diagonal elements are the
+// sum of the signs of the Tait edges between the two faces, negated. Diagonal elements are the sum of the signs of the
+// edges at the face, not counting loops, so that each row would sum to zero with the first face included.
+// The absolute value of its determinant is the determinant of the knot.
+// If there is only one white face, nil is returned. If the diagram is not planar, NonPlanar is returned.
+func (k *Knot) GoeritzMatrix() (*poly.Int64M, error) {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return nil, nil
+	}
+
+	faces := d.faces()
+	if len(faces) != len(d.x)+2 {
+		return nil, NonPlanar
+	}
+
+	m, _ := d.goeritz(faces, d.checkerboard(faces))
+	return m, nil
+}
+
+// edges returns the edges of the knot, in order of linkage, numbered the same way as in the diagram.
+func (k Knot) edges() []Edge {
+	ret := []Edge{}
+	for _, a := range k.Arcs() {
+		for i := 0; i <= len(a.Over); i++ {
+			ret = append(ret, Edge{a, i})
+		}
+	}
+
+	return ret
+}
+
+// checkerboard colors the faces of a planar diagram black (false) and white (true), so that faces on the two sides of
+// each edge have different colors. The first face is white.
+func (d *diagram) checkerboard(faces [][][2]int) []bool {
+	face := faceIndex(faces)
+	twins := d.twins()
+	white := make([]bool, len(faces))
+	seen := make([]bool, len(faces))
+	white[0], seen[0] = true, true
+	for queue := []int{0}; len(queue) > 0; queue = queue[1:] {
+		f := queue[0]
+		for _, end := range faces[f] {
+			if g := face[twins[end]]; !seen[g] {
+				white[g], seen[g] = !white[f], true
+				queue = append(queue, g)
+			}
+		}
+	}
+
+	return white
+}
+
+// tait returns the edges of the Tait graph of a planar diagram, one for each cross, without setting the crosses.
+// The face at the corner after the j-th position around a cross is the one containing the end of the edge at that
+// position. The white faces are either after the first and third positions, or after the second and fourth.
+func (d *diagram) tait(faces [][][2]int, white []bool) []TaitEdge {
+	face := faceIndex(faces)
+	ret := make([]TaitEdge, len(d.x))
+	for i := range d.x {
+		corner, sign := 0, 1
+		if !white[face[[2]int{i, 0}]] {
+			corner, sign = 1, -1
+		}
+		ret[i] = TaitEdge{From: face[[2]int{i, corner}], To: face[[2]int{i, corner + 2}], Sign: sign}
+	}
+
+	return ret
+}
+
+// goeritz returns the Goeritz matrix of a planar diagram, see GoeritzMatrix(), together with the Gordon–Litherland
+// correction term: the sum of the signs of the Tait edges at crosses where the white faces are between an incoming and
+// an outgoing edge.
+func (d *diagram) goeritz(faces [][][2]int, white []bool) (*poly.Int64M, int) {
+	index := map[int]int{}
+	for f, w := range white {
+		if w {
+			index[f] = len(index)
+		}
+	}
+
+	g := make([][]int64, len(index))
+	for i := range g {
+		g[i] = make([]int64, len(index))
+	}
+	mu := 0
+	for i, e := range d.tait(faces, white) {
+		// The corner after the first position is between an incoming and an outgoing edge if the cross is right-handed.
+		if (e.Sign > 0) == (d.x[i].h == Right) {
+			mu += e.Sign
+		}
+		a, b, s := index[e.From], index[e.To], int64(e.Sign)
+		if a == b {
+			continue
+		}
+		g[a][b] -= s
+		g[b][a] -= s
+		g[a][a] += s
+		g[b][b] += s
+	}
+	if len(g) == 1 {
+		return nil, mu
+	}
+
+	n := len(g) - 1
+	m := poly.NewInt64M(uint(n), uint(n))
+	for i := range m.Elements {
+		m.Elements[i][0].C = g[i/n+1][i%n+1]
+	}
+
+	return m, mu
+}
+
+// faceIndex maps each edge end to the index of its face.
+func faceIndex(faces [][][2]int) map[[2]int]int {
+	ret := map[[2]int]int{}
+	for f, ends := range faces {
+		for _, end := range ends {
+			ret[end] = f
+		}
+	}
+
+	return ret
+}
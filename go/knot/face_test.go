@@ -0,0 +1,170 @@
+package knot_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestFaces(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[2])
+	fiveTwo, err := knot.FromDTCode([]int{4, 8, 10, 2, 6})
+	if err != nil {
+		t.Fatalf("FromDTCode() returned error: %v", err)
+	}
+
+	for i, k := range []*knot.Knot{
+		knot.Unknot(),
+		knot.Trefoil(),
+		twisted,
+		fiveTwo,
+		knot.Braid{3, []int{1, -2, 1, -2, 1, -2, 1, -2}}.Closure(),
+	} {
+		faces, err := k.Faces()
+		if err != nil {
+			t.Errorf("#%d: Faces() returned error: %v", i+1, err)
+			continue
+		}
+		if got, want := len(faces), k.Size()+2; got != want {
+			t.Errorf("#%d: len(Faces()) = %d; want: %d", i+1, got, want)
+		}
+		if faces[0].Black {
+			t.Errorf("#%d: Faces()[0].Black = true; want: false", i+1)
+		}
+
+		// Each edge bounds a face on its left and one on its right, with different colors.
+		left, right := map[knot.Edge]bool{}, map[knot.Edge]bool{}
+		for _, f := range faces {
+			for _, s := range f.Sides {
+				black := left
+				if !s.Left {
+					black = right
+				}
+				if _, ok := black[s.Edge]; ok {
+					t.Errorf("#%d: edge %v bounds two faces on the same side", i+1, s.Edge)
+				}
+				black[s.Edge] = f.Black
+			}
+		}
+		edges := 0
+		for _, a := range k.Arcs() {
+			for j := 0; j <= len(a.Over) && a.Start != nil || j == 0; j++ {
+				e := knot.Edge{Arc: a, Index: j}
+				if l, ok := left[e]; !ok || l == right[e] {
+					t.Errorf("#%d: edge %v has colors %v and %v", i+1, e, left[e], right[e])
+				}
+				edges++
+			}
+		}
+		if len(left) != edges || len(right) != edges {
+			t.Errorf("#%d: faces have %d and %d sides; want: %d", i+1, len(left), len(right), edges)
+		}
+	}
+}
+
+func TestFacesNonPlanar(t *testing.T) {
+	k := knot.SimpleKnot(5)
+	if _, err := k.Faces(); !errors.Is(err, knot.NonPlanar) {
+		t.Errorf("Faces() error = %v; want: %v", err, knot.NonPlanar)
+	}
+	if _, err := k.TaitGraph(); !errors.Is(err, knot.NonPlanar) {
+		t.Errorf("TaitGraph() error = %v; want: %v", err, knot.NonPlanar)
+	}
+	if _, err := k.GoeritzMatrix(); !errors.Is(err, knot.NonPlanar) {
+		t.Errorf("GoeritzMatrix() error = %v; want: %v", err, knot.NonPlanar)
+	}
+}
+
+func TestEdge(t *testing.T) {
+	k := knot.Trefoil()
+	a := k.Arcs()[0]
+	for i, row := range []struct {
+		e           knot.Edge
+		start, stop *knot.Cross
+	}{
+		{knot.Edge{Arc: a, Index: 0}, a.Start, a.Over[0]},
+		{knot.Edge{Arc: a, Index: 1}, a.Over[0], a.Stop},
+	} {
+		if got := row.e.Start(); got != row.start {
+			t.Errorf("#%d: Start() = %p; want: %p", i+1, got, row.start)
+		}
+		if got := row.e.Stop(); got != row.stop {
+			t.Errorf("#%d: Stop() = %p; want: %p", i+1, got, row.stop)
+		}
+	}
+}
+
+func TestTaitGraph(t *testing.T) {
+	twisted := knot.Unknot()
+	knot.TwistRight(twisted.Arcs()[0])
+	trefoil := knot.Trefoil()
+	knot.TwistLeft(trefoil.Arcs()[0])
+	knot.TwistLeft(trefoil.Arcs()[0])
+
+	for i, row := range []struct {
+		k            *knot.Knot
+		loops, signs int
+	}{
+		{knot.Unknot(), 0, 0},
+		{twisted, 0, 1},
+		{trefoil, 1, 3},
+		{knot.Trefoil(), 0, 3},
+		{knot.Braid{2, []int{1, 1, 1}}.Closure(), 0, 3},
+		{knot.Braid{3, []int{1, -2, 1, -2}}.Closure(), 0, 4},
+	} {
+		edges, err := row.k.TaitGraph()
+		if err != nil {
+			t.Errorf("#%d: TaitGraph() returned error: %v", i+1, err)
+			continue
+		}
+		faces, _ := row.k.Faces()
+		crosses := row.k.Crosses()
+		if len(edges) != len(crosses) {
+			t.Errorf("#%d: len(TaitGraph()) = %d; want: %d", i+1, len(edges), len(crosses))
+			continue
+		}
+		loops, signs := 0, 0
+		for j, e := range edges {
+			if e.Cross != crosses[j] {
+				t.Errorf("#%d: TaitGraph()[%d].Cross = %p; want: %p", i+1, j, e.Cross, crosses[j])
+			}
+			if faces[e.From].Black || faces[e.To].Black {
+				t.Errorf("#%d: TaitGraph()[%d] connects black faces", i+1, j)
+			}
+			if e.From == e.To {
+				loops++
+			}
+			signs += e.Sign
+		}
+		if loops != row.loops || signs != row.signs {
+			t.Errorf("#%d: TaitGraph() has %d loops, signs summing to %d; want: %d, %d", i+1, loops, signs, row.loops, row.signs)
+		}
+	}
+}
+
+func TestGoeritzMatrix(t *testing.T) {
+	for i, k := range []*knot.Knot{
+		knot.Trefoil(),
+		knot.Braid{2, []int{1, 1, 1, 1, 1}}.Closure(),
+		knot.Braid{3, []int{1, -2, 1, -2}}.Closure(),
+		knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure(),
+		knot.Braid{3, []int{1, -2, 1, -2, 1, -2, 1, -2}}.Closure(),
+	} {
+		m, err := k.GoeritzMatrix()
+		if err != nil {
+			t.Errorf("#%d: GoeritzMatrix() returned error: %v", i+1, err)
+			continue
+		}
+		det := m.Det()
+		if got := det[0].C; uint64(max(got, -got)) != k.Det() {
+			t.Errorf("#%d: det(GoeritzMatrix()) = %d; want: ±%d", i+1, got, k.Det())
+		}
+	}
+
+	if m, err := knot.Unknot().GoeritzMatrix(); m != nil || err != nil {
+		t.Errorf("Unknot().GoeritzMatrix() = %v, %v; want: nil, nil", m, err)
+	}
+}
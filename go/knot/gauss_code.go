@@ -0,0 +1,269 @@
+package knot
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// InvalidGaussCode indicates a malformed Gauss code.
+var InvalidGaussCode = errors.New("knot: invalid Gauss code")
+
+// GaussCode returns the Gauss code of the knot, e.g. "O1 U2 O3 U1 O2 U3" for the trefoil.
+// Crosses are numbered in order of first passing them, walking from the start of the first arc. Each cross is passed
+// twice, once over (O) and once under (U).
+// The code does not include handedness, so mirror images have the same code. ParseGaussCode() returns the one where the
+// first cross is left-handed, e.g. the left-handed trefoil for the code above, even for a right-handed trefoil.
+func (k *Knot) GaussCode() string {
+	return k.gaussCode(false)
+}
+
+// ExtendedGaussCode returns the Gauss code of the knot, with the handedness of each cross added as a sign, e.g.
+// "O1- U2- O3- U1- O2- U3-" for the left-handed trefoil. Right-handed (positive) crosses are marked with "+".
+// Unlike GaussCode(), this is lossless: ParseGaussCode() returns the same diagram, with the same handedness.
+func (k *Knot) ExtendedGaussCode() string {
+	return k.gaussCode(true)
+}
+
+func (k *Knot) gaussCode(signs bool) string {
+	index := map[*Cross]int{}
+	parts := []string{}
+	pass := func(c *Cross, over bool) {
+		if _, ok := index[c]; !ok {
+			index[c] = len(index) + 1
+		}
+		s := fmt.Sprintf("U%d", index[c])
+		if over {
+			s = fmt.Sprintf("O%d", index[c])
+		}
+		if signs && c.Handedness == Right {
+			s += "+"
+		} else if signs {
+			s += "-"
+		}
+		parts = append(parts, s)
+	}
+	for _, a := range k.Arcs() {
+		for _, c := range a.Over {
+			pass(c, true)
+		}
+		if a.Stop != nil {
+			pass(a.Stop, false)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// ParseGaussCode creates a knot from its Gauss code, as returned by Knot.GaussCode() or Knot.ExtendedGaussCode().
+// Passes can be separated by spaces or commas, and crosses can be numbered arbitrarily.
+// If the code has no signs, the handedness of crosses is found so that the diagram is planar. If this is not possible,
+// NonPlanar is returned. Mirror images have the same code without signs, and the one where the first cross is
+// left-handed is returned, see planarKnot(). Chiral knots can thus come back mirrored: "O1 U2 O3 U1 O2 U3" is always
+// the left-handed trefoil.
+// Extended codes are lossless, and used as they are, even if the diagram is not planar.
+func ParseGaussCode(s string) (*Knot, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	if len(fields) == 0 {
+		return Unknot(), nil
+	}
+
+	last := fields[0][len(fields[0])-1]
+	signed := last == '+' || last == '-'
+	index := map[int]int{}
+	cross, over := make([]int, len(fields)), make([]bool, len(fields))
+	signs := []Handedness{}
+	passes := [][2]bool{}
+	for p, f := range fields {
+		if len(f) < 2 || (f[0] != 'O' && f[0] != 'U') {
+			return nil, fmt.Errorf("%w: %q at position %d must start with O or U", InvalidGaussCode, f, p+1)
+		}
+		num, sign := f[1:], byte(0)
+		if last := num[len(num)-1]; last == '+' || last == '-' {
+			num, sign = num[:len(num)-1], last
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%w: %q at position %d has no valid cross number", InvalidGaussCode, f, p+1)
+		}
+		if (sign != 0) != signed {
+			return nil, fmt.Errorf("%w: %q at position %d must be signed like the rest", InvalidGaussCode, f, p+1)
+		}
+
+		i, ok := index[n]
+		if !ok {
+			i = len(index)
+			index[n] = i
+			passes = append(passes, [2]bool{})
+			if sign != 0 {
+				signs = append(signs, sign == '+')
+			}
+		}
+		cross[p], over[p] = i, f[0] == 'O'
+		if passes[i][0] && over[p] || passes[i][1] && !over[p] {
+			return nil, fmt.Errorf("%w: cross %d at position %d is already passed %c", InvalidGaussCode, n, p+1, f[0])
+		}
+		if over[p] {
+			passes[i][0] = true
+		} else {
+			passes[i][1] = true
+		}
+		if sign != 0 && signs[i] != (sign == '+') {
+			return nil, fmt.Errorf("%w: cross %d at position %d changes its sign", InvalidGaussCode, n, p+1)
+		}
+	}
+	for n, i := range index {
+		if !passes[i][0] || !passes[i][1] {
+			return nil, fmt.Errorf("%w: cross %d must be passed both over and under", InvalidGaussCode, n)
+		}
+	}
+
+	if !signed {
+		return planarKnot(cross, over)
+	}
+	crosses := make([]*Cross, len(signs))
+	for i, h := range signs {
+		crosses[i] = &Cross{Handedness: h}
+	}
+
+	return gaussKnot(cross, over, crosses), nil
+}
+
+// planarKnot links the crosses along a sequence of passes, going over or under each cross, so that the diagram is
+// planar. If this is not possible, NonPlanar is returned.
+// The position of crosses in the plane is found using their interlacement: each pair of interlaced crosses is passed
+// in the same or opposite directions, depending on the number of crosses interlaced with both.
+// Mirror images have the same sequence of passes. Of the two, the one where the first cross is left-handed is returned.
+// The same applies to parts of the diagram that can be mirrored on their own, as in composite knots.
+func planarKnot(cross []int, over []bool) (*Knot, error) {
+	n := len(cross) / 2
+	// Whether the cross is passed over at the even position. In planar diagrams, each cross is passed once at an odd
+	// and once at an even position.
+	overEven := make([]bool, n)
+	for p, i := range cross {
+		if p%2 == 0 {
+			overEven[i] = over[p]
+		}
+	}
+
+	// Two crosses are interlaced if one is passed exactly once between passing the other one twice.
+	first := make([]int, n)
+	for p := len(cross) - 1; p >= 0; p-- {
+		first[cross[p]] = p
+	}
+	interlaced := make([][]bool, n)
+	for i := range interlaced {
+		interlaced[i] = make([]bool, n)
+	}
+	for p, i := range cross {
+		if p != first[i] {
+			continue
+		}
+		for q := p + 1; cross[q] != i; q++ {
+			interlaced[i][cross[q]] = !interlaced[i][cross[q]]
+		}
+	}
+	common := func(i, j int) (ret int) {
+		for k := range interlaced {
+			if interlaced[i][k] && interlaced[j][k] {
+				ret++
+			}
+		}
+		return
+	}
+
+	// Each cross must be interlaced with an even number of crosses, and so must any two crosses that are not
+	// interlaced with each other. Interlaced crosses sharing an odd number of interlaced crosses are passed the same
+	// way, the rest are passed in opposite ways.
+	for i := range interlaced {
+		if common(i, i)%2 != 0 {
+			return nil, NonPlanar
+		}
+		for j := range interlaced {
+			if i != j && !interlaced[i][j] && common(i, j)%2 != 0 {
+				return nil, NonPlanar
+			}
+		}
+	}
+	sign := make([]int, n)
+	for i := range sign {
+		if sign[i] != 0 {
+			continue
+		}
+		// Pick the mirror image where the first cross is left-handed.
+		sign[i] = -1
+		if overEven[i] {
+			sign[i] = 1
+		}
+		for queue := []int{i}; len(queue) > 0; queue = queue[1:] {
+			j := queue[0]
+			for k, ok := range interlaced[j] {
+				if !ok {
+					continue
+				}
+				s := sign[j]
+				if common(j, k)%2 == 0 {
+					s = -s
+				}
+				if sign[k] == 0 {
+					sign[k] = s
+					queue = append(queue, k)
+				} else if sign[k] != s {
+					return nil, NonPlanar
+				}
+			}
+		}
+	}
+
+	crosses := make([]*Cross, n)
+	for i := range crosses {
+		crosses[i] = &Cross{Handedness: (sign[i] > 0) != overEven[i]}
+	}
+	k := gaussKnot(cross, over, crosses)
+	if len(k.diagram().faces()) != n+2 {
+		return nil, NonPlanar
+	}
+
+	return k, nil
+}
+
+// gaussKnot links the crosses along a sequence of passes, going over or under each cross.
+// The first arc starts after the last pass going under.
+func gaussKnot(cross []int, over []bool, crosses []*Cross) *Knot {
+	return &Knot{start: gaussArc(cross, over, crosses)}
+}
+
+// gaussArc links the crosses along a closed sequence of passes, going over or under each cross, and returns the
+// arc starting after the last pass going under. If there is no such pass, the returned arc has no start or stop.
+func gaussArc(cross []int, over []bool, crosses []*Cross) *Arc {
+	start := 0
+	for p := range cross {
+		if !over[p] {
+			start = p + 1
+		}
+	}
+
+	first := &Arc{}
+	a := first
+	for n := range cross {
+		p := (start + n) % len(cross)
+		c := crosses[cross[p]]
+		if over[p] {
+			c.Over = a
+			a.Over = append(a.Over, c)
+			continue
+		}
+		a.Stop, c.In = c, a
+		if n == len(cross)-1 {
+			a = first
+		} else {
+			a = &Arc{}
+		}
+		a.Start, c.Out = c, a
+	}
+
+	return first
+}
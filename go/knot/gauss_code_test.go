@@ -0,0 +1,105 @@
+package knot_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+// mustParseGaussCode parses a Gauss code, panicking on error. Used to build test diagrams.
+func mustParseGaussCode(code string) *knot.Knot {
+	k, err := knot.ParseGaussCode(code)
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+func TestGaussCode(t *testing.T) {
+	for i, row := range []struct {
+		k        *knot.Knot
+		gauss    string
+		extended string
+	}{
+		{knot.Unknot(), "", ""},
+		{knot.Trefoil(), "O1 U2 O3 U1 O2 U3", "O1- U2- O3- U1- O2- U3-"},
+		{knot.FigureEight(), "O1 U2 O3 U4 O2 U1 O4 U3", "O1+ U2+ O3- U4- O2+ U1+ O4- U3-"},
+	} {
+		if got, want := row.k.GaussCode(), row.gauss; got != want {
+			t.Errorf("#%d: GaussCode() = %q; want: %q", i+1, got, want)
+		}
+		if got, want := row.k.ExtendedGaussCode(), row.extended; got != want {
+			t.Errorf("#%d: ExtendedGaussCode() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestParseGaussCode(t *testing.T) {
+	for i, row := range []struct {
+		code      string
+		alexander string
+		jones     string
+	}{
+		{"", "1", "1"},
+		{"O1 U1", "1", "1"},
+		{"O1 U2 O3 U1 O2 U3", "x - 1 + x¯¹", "x¯¹ + x¯³ - x¯⁴"},
+		{"O1, U2, O3, U1, O2, U3", "x - 1 + x¯¹", "x¯¹ + x¯³ - x¯⁴"},
+		{"O1+ U2+ O3+ U1+ O2+ U3+", "x - 1 + x¯¹", "-x⁴ + x³ + x"},
+		{"U7 O3 U5 O7 U3 O5", "x - 1 + x¯¹", "x¯¹ + x¯³ - x¯⁴"},
+		{"O1 U2 O3 U4 O2 U1 O4 U3", "-x + 3 - x¯¹", "x² - x + 1 - x¯¹ + x¯²"},
+	} {
+		k, err := knot.ParseGaussCode(row.code)
+		if err != nil {
+			t.Errorf("#%d: ParseGaussCode(%q) returned error: %v", i+1, row.code, err)
+			continue
+		}
+		if got, want := k.Alexander().String(), row.alexander; got != want {
+			t.Errorf("#%d: ParseGaussCode(%q).Alexander() = %q; want: %q", i+1, row.code, got, want)
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: ParseGaussCode(%q).Jones() = %q; want: %q", i+1, row.code, got, want)
+		}
+	}
+}
+
+func TestParseGaussCodeRoundTrip(t *testing.T) {
+	for i, k := range []*knot.Knot{
+		knot.Trefoil(),
+		knot.FigureEight(),
+		knot.SimpleKnot(5),
+	} {
+		code := k.ExtendedGaussCode()
+		got, err := knot.ParseGaussCode(code)
+		if err != nil {
+			t.Errorf("#%d: ParseGaussCode(%q) returned error: %v", i+1, code, err)
+			continue
+		}
+		if got.ExtendedGaussCode() != code || got.String() != k.String() {
+			t.Errorf("#%d: ParseGaussCode(%q) = %v; want: %v", i+1, code, got, k)
+		}
+	}
+}
+
+func TestParseGaussCodeError(t *testing.T) {
+	for i, row := range []struct {
+		code string
+		err  error
+	}{
+		{"X1 U1", knot.InvalidGaussCode},
+		{"O U1", knot.InvalidGaussCode},
+		{"Oa U1", knot.InvalidGaussCode},
+		{"O1", knot.InvalidGaussCode},
+		{"O1 O1", knot.InvalidGaussCode},
+		{"O1 U2", knot.InvalidGaussCode},
+		{"O1+ U1", knot.InvalidGaussCode},
+		{"O1 U1+", knot.InvalidGaussCode},
+		{"O1+ U1-", knot.InvalidGaussCode},
+		{"O1 U2 O3 U1 O2 O4 U3 U4", knot.NonPlanar},
+	} {
+		if _, err := knot.ParseGaussCode(row.code); !errors.Is(err, row.err) {
+			t.Errorf("#%d: ParseGaussCode(%q) error = %v; want: %v", i+1, row.code, err, row.err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package knot
+
+import (
+	"github.com/attilaolah/math/go/poly"
+)
+
+// HOMFLY calculates the HOMFLY-PT polynomial P(v, z) of the knot, with v and z as the first and second indeterminate.
+// It is 1 for the unknot, and satisfies the skein relation v⁻¹P(L₊) - vP(L₋) = zP(L₀).
+// Substituting v = t and z = t^(1/2) - t^(-1/2) gives the Jones polynomial.
+// The skein recursion takes time exponential in the number of crosses, even with memoization: diagrams with a few
+// dozen crosses can take minutes. If the diagram is not planar, nil is returned.
+func (k *Knot) HOMFLY() poly.Int64P {
+	d := k.diagram()
+	if len(d.faces()) != len(d.x)+2 && len(d.x) > 0 {
+		return nil
+	}
+
+	return d.homfly(map[string]poly.Int64P{})
+}
+
+// homfly calculates the HOMFLY-PT polynomial of the diagram using the skein relation.
+// Components are walked in order, and the first cross that is reached going under gets switched. This is repeated
+// until each component only goes over crosses that have not been reached yet, which makes the diagram an unlink.
+// Results are cached in 'memo', keyed by the diagram.
+func (d *diagram) homfly(memo map[string]poly.Int64P) poly.Int64P {
+	d = d.untwisted()
+	key := d.key()
+	if p, ok := memo[key]; ok {
+		return p
+	}
+
+	i := d.descending()
+	if i < 0 {
+		// Unlink: P = ((v⁻¹ - v)/z)ⁿ⁻¹
+		ret := poly.Int64P{vz(1, 0, 0)}
+		for n := d.components(); n > 1; n-- {
+			ret = ret.Mul(poly.Int64P{vz(1, -1, -1), vz(-1, 1, -1)})
+		}
+		memo[key] = ret
+		return ret
+	}
+
+	// P(L₊) = v²P(L₋) + vzP(L₀)
+	// P(L₋) = v⁻²P(L₊) - v⁻¹zP(L₀)
+	switched := d.switched(i).homfly(memo).MulT(vz(1, 2, 0))
+	smoothed := d.smoothed(i).homfly(memo).MulT(vz(1, 1, 1))
+	if d.x[i].h == Left {
+		switched = switched.MulT(vz(1, -4, 0))
+		smoothed = smoothed.MulT(vz(-1, -2, 0))
+	}
+	ret := trim(poly.Int64P{}.Add(switched).Add(smoothed))
+	memo[key] = ret
+
+	return ret
+}
+
+// descending returns the first cross reached going under, when walking the components in order.
+// If each cross is first reached going over, -1 is returned.
+func (d *diagram) descending() int {
+	heads := d.heads()
+	seen := make([]bool, len(d.x))
+	for _, component := range d.walk() {
+		for _, e := range component {
+			h := heads[e]
+			if !seen[h[0]] && h[1] == 0 {
+				return h[0]
+			}
+			seen[h[0]] = true
+		}
+	}
+
+	return -1
+}
+
+// vz returns the term c·vⁱzʲ.
+func vz(c, i, j int64) poly.Int64T {
+	return poly.Int64T{Ind: poly.Ind{i, j}, C: c}
+}
@@ -0,0 +1,33 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestHOMFLY(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want string
+	}{
+		{knot.Unknot(), "1"},
+		{knot.Trefoil(), "x¯²y² + 2x¯² - x¯⁴"},
+		{knot.RightTrefoil(), "-x⁴ + x²y² + 2x²"},
+		{knot.FigureEight(), "x² - y² - 1 + x¯²"},
+		// Unknot with two opposite twists.
+		{mustParseGaussCode("O1- U2+ O2+ U1-"), "1"},
+		// Trefoil with two opposite twists.
+		{mustParseGaussCode("O1- U2- O3- U4+ O4+ O5- U5- U1- O2- U3-"), "x¯²y² + 2x¯² - x¯⁴"},
+	} {
+		if got, want := row.k.HOMFLY().String(), row.want; got != want {
+			t.Errorf("#%d: HOMFLY() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestHOMFLYNonPlanar(t *testing.T) {
+	if p := knot.SimpleKnot(5).HOMFLY(); p != nil {
+		t.Errorf("SimpleKnot(5).HOMFLY() = %q; want: nil", p)
+	}
+}
@@ -0,0 +1,183 @@
+package knot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/attilaolah/math/go/poly"
+)
+
+// KauffmanBracket calculates the Kauffman bracket ⟨K⟩ of the knot diagram, as a Laurent polynomial in A.
+// It is normalized so that the crossingless unknot is 1. The bracket is only invariant under the second and third
+// Reidemeister moves; see Jones() for the knot invariant.
+// There is no limit on the number of crosses. The cost grows exponentially with the number of edges cut by a line
+// sweeping across the diagram, which stays small for braid closures and other long, narrow diagrams.
+// If the diagram is not planar, nil is returned.
+func (k *Knot) KauffmanBracket() poly.Int64P {
+	d := k.diagram()
+	if len(d.faces()) != len(d.x)+2 && len(d.x) > 0 {
+		return nil
+	}
+
+	return d.bracket()
+}
+
+// Jones calculates the Jones polynomial V(t) of the knot.
+// It is the Kauffman bracket normalized by (-A³)⁻ʷ, where w is the writhe, with A = t^(-1/4).
+// See KauffmanBracket() for the cost of the calculation. If the diagram is not planar, nil is returned.
+func (k *Knot) Jones() poly.Int64P {
+	bracket := k.KauffmanBracket()
+	if bracket == nil {
+		return nil
+	}
+
+	w := int64(k.Writhe())
+	sign := int64(1)
+	if w%2 != 0 {
+		sign = -1
+	}
+	ret := poly.Int64P{}
+	for _, t := range bracket.MulT(term(sign, -3*w)) {
+		if t.Ind[0]%4 != 0 {
+			panic(fmt.Sprintf("knot: unexpected bracket exponent in %s", k))
+		}
+		ret = append(ret, term(t.C, -t.Ind[0]/4))
+	}
+
+	return trim(ret)
+}
+
+// bracket calculates the Kauffman bracket using a state sum.
+// Each state picks the A- or B-smoothing at every cross, and contributes A^(a - b) dⁿ⁻¹, where a and b count the
+// smoothings of each kind, n is the number of resulting loops, and d = -A² - A⁻².
+// Crosses are smoothed one at a time, and partial states are merged when they connect the edges that are not yet
+// smoothed at both ends the same way. Picking the next cross with the most such edges keeps the number of partial
+// states small, so the cost grows with the width of the diagram rather than the number of crosses.
+func (d *diagram) bracket() poly.Int64P {
+	// d = -A² - A⁻²
+	loop := poly.Int64P{term(-1, 2), term(-1, -2)}
+	// Loops without crosses are counted up front. Without any crosses, one of them is not counted.
+	ret, n := poly.Int64P{term(1, 0)}, d.loops
+	if len(d.x) == 0 {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		ret = ret.Mul(loop)
+	}
+	if len(d.x) == 0 {
+		return ret
+	}
+
+	// Each partial state maps the open edges, smoothed at one end only, to the open edge at the other end of their
+	// strand. Loops that are already closed are multiplied into the polynomial of the state.
+	type state struct {
+		open map[int]int
+		p    poly.Int64P
+	}
+	states := map[string]state{"": {map[int]int{}, ret}}
+	done, ends := make([]bool, len(d.x)), make([]int, 2*len(d.x))
+	for range d.x {
+		next, best := -1, -1
+		for i, x := range d.x {
+			n := 0
+			for _, e := range x.e {
+				if ends[e] == 1 {
+					n++
+				}
+			}
+			if !done[i] && n > best {
+				next, best = i, n
+			}
+		}
+		done[next] = true
+		for _, e := range d.x[next].e {
+			ends[e]++
+		}
+
+		x := d.x[next]
+		merged := map[string]state{}
+		for _, s := range states {
+			for _, smoothing := range [2]struct {
+				pairs [2][2]int
+				exp   int64
+			}{
+				{[2][2]int{{x.e[0], x.e[1]}, {x.e[2], x.e[3]}}, 1},
+				{[2][2]int{{x.e[0], x.e[3]}, {x.e[1], x.e[2]}}, -1},
+			} {
+				open := make(map[int]int, len(s.open)+4)
+				for e, f := range s.open {
+					open[e] = f
+				}
+				p := s.p.MulT(term(1, smoothing.exp))
+				for _, pair := range smoothing.pairs {
+					if joinEdges(open, pair[0], pair[1]) {
+						p = p.Mul(loop)
+					}
+				}
+				key := openKey(open)
+				if m, ok := merged[key]; ok {
+					m.p = m.p.Add(p)
+					merged[key] = m
+				} else {
+					merged[key] = state{open, p}
+				}
+			}
+		}
+		states = merged
+	}
+
+	// All edges are smoothed at both ends, leaving a single state. One of its loops is not counted.
+	p, err := states[""].p.Div(loop)
+	if err != nil {
+		panic("knot: should not happen")
+	}
+
+	return trim(p)
+}
+
+// joinEdges connects the ends of edges 'a' and 'b' at a smoothed cross, and reports whether this closes a loop.
+// Edges seen for the first time become open, the ones already open are closed, and the strands through them joined.
+func joinEdges(open map[int]int, a, b int) bool {
+	if a == b {
+		// An edge going from the cross back to it.
+		return true
+	}
+
+	fa, oka := open[a]
+	fb, okb := open[b]
+	if oka && okb && fa == b {
+		delete(open, a)
+		delete(open, b)
+		return true
+	}
+	if oka {
+		delete(open, a)
+	} else {
+		fa = a
+	}
+	if okb {
+		delete(open, b)
+	} else {
+		fb = b
+	}
+	open[fa], open[fb] = fb, fa
+
+	return false
+}
+
+// openKey encodes the open edges of a partial state, and how they are connected.
+func openKey(open map[int]int) string {
+	edges := make([]int, 0, len(open))
+	for e := range open {
+		edges = append(edges, e)
+	}
+	sort.Ints(edges)
+
+	var b strings.Builder
+	for _, e := range edges {
+		fmt.Fprintf(&b, "%d:%d,", e, open[e])
+	}
+
+	return b.String()
+}
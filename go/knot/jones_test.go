@@ -0,0 +1,84 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+	"github.com/attilaolah/math/go/poly"
+)
+
+func TestKauffmanBracket(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want string
+	}{
+		{knot.Unknot(), "1"},
+		{knot.Trefoil(), "x⁷ - x³ - x¯⁵"},
+		{knot.RightTrefoil(), "-x⁵ - x¯³ + x¯⁷"},
+		{mustParseGaussCode("O1- U1-"), "-x¯³"},
+		{mustParseGaussCode("O1+ U1+"), "-x³"},
+	} {
+		if got, want := row.k.KauffmanBracket().String(), row.want; got != want {
+			t.Errorf("#%d: KauffmanBracket() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestJones(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want string
+	}{
+		{knot.Unknot(), "1"},
+		{knot.Trefoil(), "x¯¹ + x¯³ - x¯⁴"},
+		{knot.RightTrefoil(), "-x⁴ + x³ + x"},
+		{knot.FigureEight(), "x² - x + 1 - x¯¹ + x¯²"},
+		// Unknot with two opposite twists.
+		{mustParseGaussCode("O1- U2+ O2+ U1-"), "1"},
+		// Trefoil with two opposite twists.
+		{mustParseGaussCode("O1- U2- O3- U4+ O4+ O5- U5- U1- O2- U3-"), "x¯¹ + x¯³ - x¯⁴"},
+	} {
+		if got, want := row.k.Jones().String(), row.want; got != want {
+			t.Errorf("#%d: Jones() = %q; want: %q", i+1, got, want)
+		}
+	}
+}
+
+func TestJonesNonPlanar(t *testing.T) {
+	k := knot.SimpleKnot(5)
+	if p := k.KauffmanBracket(); p != nil {
+		t.Errorf("SimpleKnot(5).KauffmanBracket() = %q; want: nil", p)
+	}
+	if p := k.Jones(); p != nil {
+		t.Errorf("SimpleKnot(5).Jones() = %q; want: nil", p)
+	}
+}
+
+func TestJonesLarge(t *testing.T) {
+	// The (2, n) torus knot has V(t) = t^((n-1)/2) (1 + t² - t³ + t⁴ - ... - tⁿ).
+	for i, n := range []int{3, 5, 65, 101} {
+		word := make([]int, n)
+		for j := range word {
+			word[j] = 1
+		}
+		k := knot.Braid{Strands: 2, Word: word}.Closure()
+
+		m := int64(n-1) / 2
+		want := poly.Int64P{{Ind: poly.Ind{m}, C: 1}}
+		for j := int64(2); j <= int64(n); j++ {
+			want = append(want, poly.Int64T{Ind: poly.Ind{m + j}, C: 1 - 2*(j%2)})
+		}
+		if got := k.Jones().String(); got != want.Compact().String() {
+			t.Errorf("#%d: T(2, %d): Jones() = %q; want: %q", i+1, n, got, want.Compact())
+		}
+	}
+
+	k := knot.Unknot()
+	for i := 0; i < 100; i++ {
+		arcs := k.Arcs()
+		knot.Twist(arcs[i%len(arcs)], i%3 == 0)
+	}
+	if got, want := k.Jones().String(), "1"; got != want {
+		t.Errorf("Unknot() with 100 twists: Jones() = %q; want: %q", got, want)
+	}
+}
@@ -9,6 +9,8 @@ import (
 type Knot struct {
 	// An arbitrary starting arc.
 	start *Arc
+	// Snapshots of the diagram taken before each move applied by Apply(), used by Undo().
+	history []snapshot
 }
 
 // Arcs returns the arcs in order of linkage, by arc direction.
@@ -60,10 +62,83 @@ func (k *Knot) Reverse() {
 	k.start = crosses[0].Out
 }
 
+// Clone returns a deep copy of the knot, with new arcs and crosses linked the same way.
+// Moves applied by Apply() are copied too, so they can be undone on the copy, without changing the original.
+func (k *Knot) Clone() *Knot {
+	arcs, crosses := map[*Arc]*Arc{}, map[*Cross]*Cross{}
+	arc := func(a *Arc) *Arc {
+		if a != nil && arcs[a] == nil {
+			arcs[a] = &Arc{}
+		}
+		return arcs[a]
+	}
+	cross := func(c *Cross) *Cross {
+		if c != nil && crosses[c] == nil {
+			crosses[c] = &Cross{}
+		}
+		return crosses[c]
+	}
+	copyArc := func(a Arc) Arc {
+		ret := Arc{cross(a.Start), cross(a.Stop), make([]*Cross, len(a.Over))}
+		for i, c := range a.Over {
+			ret.Over[i] = cross(c)
+		}
+		return ret
+	}
+	copyCross := func(c Cross) Cross {
+		return Cross{arc(c.Over), arc(c.In), arc(c.Out), c.Handedness}
+	}
+
+	for _, a := range k.Arcs() {
+		*arc(a) = copyArc(*a)
+	}
+	for _, c := range k.Crosses() {
+		*cross(c) = copyCross(*c)
+	}
+	ret := &Knot{start: arc(k.start)}
+	for _, s := range k.history {
+		// Arcs and crosses removed by a move only exist in the snapshots, and are copied from there.
+		t := snapshot{s.move, arc(s.start), map[*Arc]Arc{}, map[*Cross]Cross{}}
+		for a, v := range s.arcs {
+			t.arcs[arc(a)] = copyArc(v)
+		}
+		for c, v := range s.crosses {
+			t.crosses[cross(c)] = copyCross(v)
+		}
+		ret.history = append(ret.history, t)
+	}
+
+	return ret
+}
+
+// Equal reports whether the two knots have the same diagram, i.e. their arcs and crosses can be matched so that they
+// are linked the same way, with the same handedness. The choice of the starting arc does not matter, but the direction
+// of the knot does, see Reverse().
+func (k *Knot) Equal(other *Knot) bool {
+	arcs := other.Arcs()
+	if len(arcs) != len(k.Arcs()) || other.Size() != k.Size() {
+		return false
+	}
+
+	// Walking both knots from the start, crosses are numbered the same way in the extended Gauss code.
+	code := k.ExtendedGaussCode()
+	for _, a := range arcs {
+		if (&Knot{start: a}).ExtendedGaussCode() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 // String returns a visual representation of the knot.
 func (k Knot) String() string {
 	parts := []string{}
 	arcs, crosses := k.Arcs(), k.Crosses()
+	index := map[*Cross]int{}
+	for i, c := range crosses {
+		index[c] = i
+	}
 
 	for i, a := range arcs {
 		if a.Start != nil {
@@ -71,10 +146,8 @@ func (k Knot) String() string {
 		}
 		s := fmt.Sprintf("A%d", i+1)
 		over := []string{}
-		for j, c := range crosses {
-			if c.Over == a {
-				over = append(over, fmt.Sprintf("%s%d", c.Handedness, j+1))
-			}
+		for _, c := range a.Over {
+			over = append(over, fmt.Sprintf("%s%d", c.Handedness, index[c]+1))
 		}
 		if len(over) != 0 {
 			s = fmt.Sprintf("%s{%s}", s, strings.Join(over, ", "))
@@ -85,7 +85,7 @@ func TestString(t *testing.T) {
 	rows := []row{
 		{knot.Unknot(), "A1"},
 		{knot.Trefoil(), "L1 A1{L3} L2 A2{L1} L3 A3{L2} L1"},
-		{knot.FigureEight(), "L1 A1{L4} L2 A2{L1} L3 A3{L2} L4 A4{L3} L1"},
+		{knot.FigureEight(), "L1 A1{R4} R2 A2{L1} L3 A3{R2} R4 A4{L3} L1"},
 	}
 	{
 		k := knot.Unknot()
@@ -112,8 +112,7 @@ func TestString(t *testing.T) {
 	{
 		k := knot.Trefoil()
 		knot.TwistLeft(k.Arcs()[0])
-		// TODO: Should be A1{L4, L2} to keep ordering of crosses.
-		rows = append(rows, row{k, "L1 A1{L2, L4} L2 A2 L3 A3{L1} L4 A4{L3} L1"})
+		rows = append(rows, row{k, "L1 A1{L4, L2} L2 A2 L3 A3{L1} L4 A4{L3} L1"})
 	}
 
 	for i, row := range rows {
@@ -122,3 +121,108 @@ func TestString(t *testing.T) {
 		}
 	}
 }
+
+func TestClone(t *testing.T) {
+	for i, moves := range []string{
+		"",
+		"Twist(1, L)",
+		"Twist(1, R) Untwist(2)",
+		"Poke(1, 2, L) Twist(4, R)",
+		"Poke(1, 2, L) Unpoke(3, 4) Twist(3, R)",
+	} {
+		k := knot.Trefoil()
+		ms, err := knot.ParseMoves(moves)
+		if err != nil {
+			t.Fatalf("#%d: ParseMoves(%q) returned error: %v", i+1, moves, err)
+		}
+		if err := k.Apply(ms); err != nil {
+			t.Fatalf("#%d: Apply(%q) returned error: %v", i+1, moves, err)
+		}
+		want := k.String()
+
+		c := k.Clone()
+		if got := c.String(); got != want {
+			t.Errorf("#%d: k.Clone().String() = %q; want: %q", i+1, got, want)
+		}
+		if !c.Equal(k) {
+			t.Errorf("#%d: k.Clone().Equal(k) = false; want: true", i+1)
+		}
+		if got := c.History().String(); got != moves {
+			t.Errorf("#%d: k.Clone().History() = %q; want: %q", i+1, got, moves)
+		}
+		arcs, crosses := map[*knot.Arc]bool{}, k.Crosses()
+		for _, a := range k.Arcs() {
+			arcs[a] = true
+		}
+		for j, a := range c.Arcs() {
+			if arcs[a] {
+				t.Errorf("#%d: k.Clone().Arcs()[%d] is shared with k", i+1, j)
+			}
+		}
+		for j, x := range c.Crosses() {
+			if x == crosses[j] {
+				t.Errorf("#%d: k.Clone().Crosses()[%d] is shared with k", i+1, j)
+			}
+		}
+
+		// Changing the copy must not change the original.
+		for c.Undo() {
+		}
+		if got, want := c.String(), knot.Trefoil().String(); got != want {
+			t.Errorf("#%d: after undoing all moves on the copy: c.String() = %q; want: %q", i+1, got, want)
+		}
+		knot.TwistRight(c.Arcs()[0])
+		if got := k.String(); got != want {
+			t.Errorf("#%d: after changing the copy: k.String() = %q; want: %q", i+1, got, want)
+		}
+		if got := k.History().String(); got != moves {
+			t.Errorf("#%d: after changing the copy: k.History() = %q; want: %q", i+1, got, moves)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	apply := func(k *knot.Knot, moves string) *knot.Knot {
+		ms, err := knot.ParseMoves(moves)
+		if err != nil {
+			t.Fatalf("ParseMoves(%q) returned error: %v", moves, err)
+		}
+		if err := k.Apply(ms); err != nil {
+			t.Fatalf("Apply(%q) returned error: %v", moves, err)
+		}
+		return k
+	}
+	// A trefoil with a twist, which unlike the trefoil is not the same diagram when reversed.
+	const code = "O1- U2- O3- U1- O4- U4- O2- U3-"
+	reversed := mustParseGaussCode(code)
+	reversed.Reverse()
+
+	for i, row := range []struct {
+		k, other *knot.Knot
+		want     bool
+	}{
+		{knot.Unknot(), knot.Unknot(), true},
+		{knot.Trefoil(), knot.Trefoil(), true},
+		{mustParseGaussCode(code), reversed, false},
+		{knot.Trefoil(), knot.RightTrefoil(), false},
+		{knot.Trefoil(), knot.FigureEight(), false},
+		{knot.Unknot(), apply(knot.Unknot(), "Twist(1, L)"), false},
+		// The same diagrams, starting at different arcs.
+		{mustParseGaussCode(code), mustParseGaussCode("O4- U4- O2- U3- O1- U2- O3- U1-"), true},
+		{mustParseGaussCode(code), mustParseGaussCode("O1- U1- O2- U3- O4- U2- O3- U4-"), true},
+		{apply(knot.Trefoil(), "Twist(1, L)"), apply(knot.Trefoil(), "Twist(2, L)"), true},
+		{apply(knot.Trefoil(), "Twist(1, R)"), apply(knot.Trefoil(), "Twist(3, R)"), true},
+		{apply(knot.Trefoil(), "Twist(1, L)"), apply(knot.Trefoil(), "Twist(1, R)"), false},
+		{apply(knot.Unknot(), "Twist(1, L) Twist(1, L)"), apply(knot.Unknot(), "Twist(1, L) Twist(1, R)"), false},
+		// The same knot, with different diagrams.
+		{apply(knot.Trefoil(), "Twist(1, L) Untwist(2)"), knot.Trefoil(), true},
+		{apply(knot.Trefoil(), "Poke(1, 2, L)"), knot.Trefoil(), false},
+	} {
+		if got := row.k.Equal(row.other); got != row.want {
+			t.Errorf("#%d: k.Equal(%q) = %v; want: %v", i+1, row.other, got, row.want)
+		}
+		if got := row.other.Equal(row.k); got != row.want {
+			t.Errorf("#%d: %q.Equal(k) = %v; want: %v", i+1, row.other, got, row.want)
+		}
+	}
+}
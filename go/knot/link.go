@@ -0,0 +1,160 @@
+package knot
+
+import (
+	"fmt"
+
+	"github.com/attilaolah/math/go/poly"
+)
+
+// Link represents a directed 2D link diagram, made of one or more knotted components.
+type Link struct {
+	// An arbitrary starting arc for each component.
+	start []*Arc
+}
+
+// NewLink creates a split link with the knots as its components.
+// The arcs and crosses of the knots become part of the link.
+func NewLink(components ...*Knot) *Link {
+	l := Link{}
+	for _, k := range components {
+		l.start = append(l.start, k.start)
+	}
+
+	return &l
+}
+
+// Link returns the knot as a link with a single component.
+// The arcs and crosses are shared with the knot.
+func (k *Knot) Link() *Link {
+	return NewLink(k)
+}
+
+// Components returns the arcs of each component, in order of linkage, by arc direction.
+// A component that never goes under any cross consists of a single arc with no start and stop.
+func (l Link) Components() [][]*Arc {
+	ret := make([][]*Arc, len(l.start))
+	for i, a := range l.start {
+		ret[i] = Knot{start: a}.Arcs()
+	}
+
+	return ret
+}
+
+// Arcs returns the arcs of all components, in order of linkage.
+func (l Link) Arcs() []*Arc {
+	ret := []*Arc{}
+	for _, arcs := range l.Components() {
+		ret = append(ret, arcs...)
+	}
+
+	return ret
+}
+
+// Crosses returns crosses corresponding to the start of each arc, in order.
+func (l Link) Crosses() []*Cross {
+	ret := []*Cross{}
+	for _, a := range l.start {
+		ret = append(ret, Knot{start: a}.Crosses()...)
+	}
+
+	return ret
+}
+
+// Size returns the number of crosses in the link.
+func (l Link) Size() int {
+	return len(l.Crosses())
+}
+
+// LinkingNumber returns the linking number of the i-th and j-th components.
+// It is half of the sum of signs of the crosses between the two components, right-handed crosses counting as +1.
+// Components are numbered as in Components(). It panics if either index is out of range.
+func (l Link) LinkingNumber(i, j int) int {
+	if n := len(l.start); i < 0 || i >= n || j < 0 || j >= n {
+		panic(fmt.Sprintf("knot: component index out of range: LinkingNumber(%d, %d) with %d components", i, j, n))
+	}
+	if i == j {
+		return 0
+	}
+
+	component := l.component()
+	sum := 0
+	for _, c := range l.Crosses() {
+		under, over := component[c.In], component[c.Over]
+		if under != i && under != j || over != i && over != j || under == over {
+			continue
+		}
+		if c.Handedness == Right {
+			sum++
+		} else {
+			sum--
+		}
+	}
+
+	return sum / 2
+}
+
+// component maps each arc to the index of its component.
+func (l Link) component() map[*Arc]int {
+	ret := map[*Arc]int{}
+	for i, arcs := range l.Components() {
+		for _, a := range arcs {
+			ret[a] = i
+		}
+	}
+
+	return ret
+}
+
+// Det calculates the link's determinant.
+// Split links, including ones with components that never go under any cross, have a determinant of 0.
+func (l *Link) Det() uint64 {
+	m := l.Matrix()
+	switch {
+	case m == nil && len(l.start) == 1:
+		return 1
+	case m == nil || m.Stride != uint(l.Size()):
+		return 0
+	case m.Stride == 1:
+		return 1
+	}
+
+	det := m.AnyMinor().Det()
+	if len(det) != 1 {
+		panic(fmt.Sprintf("knot: unexpected determinant %s", det))
+	}
+	c := det[0].C
+	if c < 0 {
+		return uint64(-c)
+	}
+	return uint64(c)
+}
+
+// Matrix generates the matrix for calculating the determinant of the link.
+// Rows correspond to crosses and columns to arcs, both in order of linkage. Each row contains 2 for the arc going
+// over, and -1 for the arcs going in and out.
+// The matrix is not square if some components never go under any cross.
+func (l *Link) Matrix() *poly.Int64M {
+	crosses, arcs := l.Crosses(), l.Arcs()
+	if len(crosses) == 0 {
+		return nil
+	}
+
+	m := poly.NewInt64M(uint(len(crosses)), uint(len(arcs)))
+	for row, c := range crosses {
+		for col, a := range arcs {
+			var f int64
+			if c.In == a {
+				f -= 1
+			}
+			if c.Out == a {
+				f -= 1
+			}
+			if c.Over == a {
+				f += 2
+			}
+			m.Elements[row*int(m.Stride)+col][0].C = f
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,105 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestLinkComponents(t *testing.T) {
+	for i, row := range []struct {
+		l    *knot.Link
+		arcs []int
+		size int
+	}{
+		{knot.NewLink(knot.Unknot()), []int{1}, 0},
+		{knot.NewLink(knot.Trefoil(), knot.Unknot()), []int{3, 1}, 3},
+		{knot.HopfLink(), []int{1, 1}, 2},
+		{knot.WhiteheadLink(), []int{3, 2}, 5},
+		{knot.Braid{3, []int{1, -2, 1, -2, 1, -2}}.Link(), []int{2, 2, 2}, 6},
+		// The first component only goes over.
+		{knot.Braid{2, []int{1, -1}}.Link(), []int{1, 2}, 2},
+	} {
+		components := row.l.Components()
+		if got, want := len(components), len(row.arcs); got != want {
+			t.Errorf("#%d: len(Components()) = %d; want: %d", i+1, got, want)
+			continue
+		}
+		for j, arcs := range components {
+			if got, want := len(arcs), row.arcs[j]; got != want {
+				t.Errorf("#%d: len(Components()[%d]) = %d; want: %d", i+1, j, got, want)
+			}
+		}
+		if got, want := row.l.Size(), row.size; got != want {
+			t.Errorf("#%d: Size() = %d; want: %d", i+1, got, want)
+		}
+	}
+}
+
+func TestLinkingNumber(t *testing.T) {
+	for i, row := range []struct {
+		l    *knot.Link
+		i, j int
+		want int
+	}{
+		{knot.HopfLink(), 0, 1, 1},
+		{knot.HopfLink(), 1, 0, 1},
+		{knot.HopfLink(), 0, 0, 0},
+		{knot.Braid{2, []int{-1, -1}}.Link(), 0, 1, -1},
+		{knot.Braid{2, []int{1, 1, 1, 1}}.Link(), 0, 1, 2},
+		{knot.WhiteheadLink(), 0, 1, 0},
+		{knot.Braid{3, []int{1, 1, 2, 2}}.Link(), 0, 1, 1},
+		{knot.Braid{3, []int{1, 1, 2, 2}}.Link(), 0, 2, 0},
+		{knot.Braid{3, []int{1, 1, 2, 2}}.Link(), 1, 2, 1},
+	} {
+		if got := row.l.LinkingNumber(row.i, row.j); got != row.want {
+			t.Errorf("#%d: LinkingNumber(%d, %d) = %d; want: %d", i+1, row.i, row.j, got, row.want)
+		}
+	}
+}
+
+func TestLinkingNumberPanic(t *testing.T) {
+	for i, row := range []struct {
+		i, j int
+	}{
+		{0, 2},
+		{2, 0},
+		{-1, 1},
+		{2, 2},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("#%d: LinkingNumber(%d, %d) did not panic", i+1, row.i, row.j)
+				}
+			}()
+			knot.HopfLink().LinkingNumber(row.i, row.j)
+		}()
+	}
+}
+
+func TestLinkDet(t *testing.T) {
+	twist := knot.Unknot()
+	knot.TwistLeft(twist.Arcs()[0])
+
+	for i, row := range []struct {
+		l   *knot.Link
+		det uint64
+	}{
+		{knot.Unknot().Link(), 1},
+		{twist.Link(), 1},
+		{knot.Trefoil().Link(), 3},
+		{knot.NewLink(knot.Unknot(), knot.Unknot()), 0},
+		{knot.NewLink(knot.Trefoil(), knot.FigureEight()), 0},
+		{knot.Braid{2, []int{1, -1}}.Link(), 0},
+		{knot.HopfLink(), 2},
+		{knot.Braid{2, []int{1, 1, 1, 1}}.Link(), 4},
+		{knot.WhiteheadLink(), 8},
+		// Borromean rings.
+		{knot.Braid{3, []int{1, -2, 1, -2, 1, -2}}.Link(), 16},
+	} {
+		if got, want := row.l.Det(), row.det; got != want {
+			t.Errorf("#%d: Det() = %d; want: %d", i+1, got, want)
+		}
+	}
+}
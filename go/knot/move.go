@@ -0,0 +1,289 @@
+package knot
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// InvalidMove indicates a move that cannot be parsed, or that refers to arcs or crosses not in the knot.
+var InvalidMove = errors.New("knot: invalid move")
+
+// MoveKind is the kind of a Reidemeister move.
+type MoveKind int
+
+// Kinds of moves:
+const (
+	// TwistMove adds a cross by the first Reidemeister move, see Twist().
+	TwistMove MoveKind = iota
+	// UntwistMove removes a cross by the first Reidemeister move, see Untwist().
+	UntwistMove
+	// PokeMove adds two crosses by the second Reidemeister move, see PokeFrom().
+	PokeMove
+	// UnpokeMove removes two crosses by the second Reidemeister move, see Unpoke().
+	UnpokeMove
+	// SlideMove slides an arc over a cross by the third Reidemeister move, see Slide().
+	SlideMove
+)
+
+// Names of moves, in their text representation.
+var moveNames = [...]string{"Twist", "Untwist", "Poke", "Unpoke", "Slide"}
+
+// A Move records a Reidemeister move applied to a knot.
+// Arcs and crosses are given by their position in Arcs() and Crosses() of the knot before the move, counting from 0:
+//   - TwistMove: the arc to twist.
+//   - UntwistMove: the cross to remove.
+//   - PokeMove: the arc going over, then the one going under.
+//   - UnpokeMove: the two crosses to remove.
+//   - SlideMove: the arc to slide, and the cross to slide it over.
+type Move struct {
+	Kind    MoveKind
+	Arcs    []int
+	Crosses []int
+	// Handedness of the new cross, for TwistMove.
+	Handedness Handedness
+	// Left is set for a PokeMove pushing the arc going under out on its left side.
+	Left bool
+}
+
+// Moves is a sequence of moves.
+type Moves []Move
+
+// snapshot is a copy of every arc and cross of the diagram.
+type snapshot struct {
+	move    Move
+	start   *Arc
+	arcs    map[*Arc]Arc
+	crosses map[*Cross]Cross
+}
+
+// String returns the name of the move, e.g. "Twist".
+func (k MoveKind) String() string {
+	if k < 0 || int(k) >= len(moveNames) {
+		return fmt.Sprintf("MoveKind(%d)", int(k))
+	}
+
+	return moveNames[k]
+}
+
+// String represents the move like a function call, with positions counting from 1, e.g. "Twist(3, R)" or
+// "Slide(2, 1)". For a PokeMove, the side of the arc going under is given as "L" (left) or "R" (right).
+func (m Move) String() string {
+	args := []string{}
+	for _, i := range m.Arcs {
+		args = append(args, strconv.Itoa(i+1))
+	}
+	for _, i := range m.Crosses {
+		args = append(args, strconv.Itoa(i+1))
+	}
+	switch m.Kind {
+	case TwistMove:
+		args = append(args, m.Handedness.String())
+	case PokeMove:
+		args = append(args, Handedness(!m.Left).String())
+	}
+
+	return fmt.Sprintf("%s(%s)", m.Kind, strings.Join(args, ", "))
+}
+
+// String represents the moves separated by spaces, e.g. "Twist(1, R) Untwist(1)".
+func (ms Moves) String() string {
+	parts := make([]string, len(ms))
+	for i, m := range ms {
+		parts[i] = m.String()
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// ParseMoves parses moves from their text representation, as returned by Moves.String().
+// Moves can be separated by spaces, commas or semicolons.
+func ParseMoves(s string) (Moves, error) {
+	ret := Moves{}
+	parts := strings.Split(s, ")")
+	if rest := strings.Trim(parts[len(parts)-1], " \t\n,;"); rest != "" {
+		return nil, fmt.Errorf("%w: %q", InvalidMove, rest)
+	}
+	for _, p := range parts[:len(parts)-1] {
+		m, err := parseMove(strings.TrimLeft(p, " \t\n,;"))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, m)
+	}
+
+	return ret, nil
+}
+
+// parseMove parses a single move, without the closing parenthesis.
+func parseMove(s string) (Move, error) {
+	name, args, ok := strings.Cut(s, "(")
+	m := Move{Kind: -1}
+	for k, n := range moveNames {
+		if n == name {
+			m.Kind = MoveKind(k)
+		}
+	}
+	if !ok || m.Kind < 0 {
+		return m, fmt.Errorf("%w: %q", InvalidMove, s+")")
+	}
+
+	// Number of arcs and crosses, and whether there is a handedness or side at the end.
+	arcs, crosses, side := 0, 0, false
+	switch m.Kind {
+	case TwistMove:
+		arcs, side = 1, true
+	case UntwistMove:
+		crosses = 1
+	case PokeMove:
+		arcs, side = 2, true
+	case UnpokeMove:
+		crosses = 2
+	case SlideMove:
+		arcs, crosses = 1, 1
+	}
+	fields := strings.Split(args, ",")
+	want := arcs + crosses
+	if side {
+		want++
+	}
+	if len(fields) != want {
+		return m, fmt.Errorf("%w: %q: wrong number of arguments", InvalidMove, s+")")
+	}
+
+	for i, f := range fields {
+		f = strings.TrimSpace(f)
+		if side && i == want-1 {
+			switch f {
+			case "R":
+				m.Handedness, m.Left = Right, false
+			case "L":
+				m.Handedness, m.Left = Left, true
+			default:
+				return m, fmt.Errorf("%w: %q: invalid side %q", InvalidMove, s+")", f)
+			}
+			if m.Kind != TwistMove {
+				m.Handedness = Left
+			}
+			continue
+		}
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 1 {
+			return m, fmt.Errorf("%w: %q: invalid position %q", InvalidMove, s+")", f)
+		}
+		if i < arcs {
+			m.Arcs = append(m.Arcs, n-1)
+		} else {
+			m.Crosses = append(m.Crosses, n-1)
+		}
+	}
+	if m.Kind != PokeMove {
+		m.Left = false
+	}
+
+	return m, nil
+}
+
+// Apply applies the moves to the knot, in order, recording them for Undo().
+// If a move is not valid, or cannot be applied, the moves applied so far are undone and an error is returned.
+func (k *Knot) Apply(moves Moves) error {
+	for i, m := range moves {
+		if err := k.apply(m); err != nil {
+			for ; i > 0; i-- {
+				k.Undo()
+			}
+			return fmt.Errorf("%w: %s", err, m)
+		}
+	}
+
+	return nil
+}
+
+// Undo restores the diagram to what it was before the last move applied by Apply(), and reports whether there was
+// such a move. Arcs and crosses removed by the move are put back, and the ones added are dropped.
+func (k *Knot) Undo() bool {
+	if len(k.history) == 0 {
+		return false
+	}
+
+	k.restore(k.history[len(k.history)-1])
+	k.history = k.history[:len(k.history)-1]
+
+	return true
+}
+
+// History returns the moves applied by Apply(), and not undone, in order.
+func (k *Knot) History() Moves {
+	ret := Moves{}
+	for _, s := range k.history {
+		ret = append(ret, s.move)
+	}
+
+	return ret
+}
+
+// apply applies a single move, recording it if successful.
+func (k *Knot) apply(m Move) error {
+	arcs, crosses := k.Arcs(), k.Crosses()
+	for _, i := range m.Arcs {
+		if i < 0 || i >= len(arcs) {
+			return fmt.Errorf("%w: no arc at position %d", InvalidMove, i+1)
+		}
+	}
+	for _, i := range m.Crosses {
+		if i < 0 || i >= len(crosses) {
+			return fmt.Errorf("%w: no cross at position %d", InvalidMove, i+1)
+		}
+	}
+	a := func(i int) *Arc { return arcs[m.Arcs[i]] }
+	c := func(i int) *Cross { return crosses[m.Crosses[i]] }
+
+	s := k.snapshot(m)
+	var err error
+	switch {
+	case m.Kind == TwistMove && len(m.Arcs) == 1 && len(m.Crosses) == 0:
+		Twist(a(0), m.Handedness)
+	case m.Kind == UntwistMove && len(m.Arcs) == 0 && len(m.Crosses) == 1:
+		err = k.Untwist(c(0))
+	case m.Kind == PokeMove && len(m.Arcs) == 2 && len(m.Crosses) == 0:
+		_, _, err = PokeFrom(a(0), a(1), m.Left)
+	case m.Kind == UnpokeMove && len(m.Arcs) == 0 && len(m.Crosses) == 2:
+		err = k.Unpoke(c(0), c(1))
+	case m.Kind == SlideMove && len(m.Arcs) == 1 && len(m.Crosses) == 1:
+		err = Slide(a(0), c(0))
+	default:
+		err = InvalidMove
+	}
+	if err != nil {
+		k.restore(s)
+		return err
+	}
+	k.history = append(k.history, s)
+
+	return nil
+}
+
+// snapshot copies every arc and cross of the knot, before applying the move.
+func (k *Knot) snapshot(m Move) snapshot {
+	s := snapshot{m, k.start, map[*Arc]Arc{}, map[*Cross]Cross{}}
+	for _, a := range k.Arcs() {
+		s.arcs[a] = Arc{a.Start, a.Stop, append([]*Cross{}, a.Over...)}
+	}
+	for _, c := range k.Crosses() {
+		s.crosses[c] = *c
+	}
+
+	return s
+}
+
+// restore puts back every arc and cross copied by the snapshot.
+func (k *Knot) restore(s snapshot) {
+	k.start = s.start
+	for a, v := range s.arcs {
+		*a = v
+	}
+	for c, v := range s.crosses {
+		*c = v
+	}
+}
@@ -0,0 +1,182 @@
+package knot_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestMoveString(t *testing.T) {
+	for i, row := range []struct {
+		m    knot.Move
+		want string
+	}{
+		{knot.Move{Kind: knot.TwistMove, Arcs: []int{2}, Handedness: knot.Right}, "Twist(3, R)"},
+		{knot.Move{Kind: knot.TwistMove, Arcs: []int{0}, Handedness: knot.Left}, "Twist(1, L)"},
+		{knot.Move{Kind: knot.UntwistMove, Crosses: []int{1}}, "Untwist(2)"},
+		{knot.Move{Kind: knot.PokeMove, Arcs: []int{0, 3}, Left: true}, "Poke(1, 4, L)"},
+		{knot.Move{Kind: knot.PokeMove, Arcs: []int{3, 0}}, "Poke(4, 1, R)"},
+		{knot.Move{Kind: knot.UnpokeMove, Crosses: []int{1, 2}}, "Unpoke(2, 3)"},
+		{knot.Move{Kind: knot.SlideMove, Arcs: []int{2}, Crosses: []int{0}}, "Slide(3, 1)"},
+	} {
+		if got := row.m.String(); got != row.want {
+			t.Errorf("#%d: m.String() = %q; want: %q", i+1, got, row.want)
+		}
+		ms, err := knot.ParseMoves(row.want)
+		if err != nil {
+			t.Errorf("#%d: ParseMoves(%q) returned error: %v", i+1, row.want, err)
+			continue
+		}
+		if len(ms) != 1 || ms.String() != row.want {
+			t.Errorf("#%d: ParseMoves(%q) = %q; want: %q", i+1, row.want, ms, row.want)
+		}
+	}
+}
+
+func TestParseMoves(t *testing.T) {
+	for i, row := range []struct {
+		s    string
+		want string
+	}{
+		{"", ""},
+		{"Twist(1, R) Untwist(2)", "Twist(1, R) Untwist(2)"},
+		{"Twist(1,L);Slide(2,3),\n Unpoke( 4, 5 ) ", "Twist(1, L) Slide(2, 3) Unpoke(4, 5)"},
+	} {
+		ms, err := knot.ParseMoves(row.s)
+		if err != nil {
+			t.Errorf("#%d: ParseMoves(%q) returned error: %v", i+1, row.s, err)
+			continue
+		}
+		if got := ms.String(); got != row.want {
+			t.Errorf("#%d: ParseMoves(%q) = %q; want: %q", i+1, row.s, got, row.want)
+		}
+	}
+}
+
+func TestParseMovesError(t *testing.T) {
+	for i, s := range []string{
+		"Twist",
+		"Twist(1, R",
+		"Twist(1)",
+		"Twist(1, X)",
+		"Twist(0, R)",
+		"Untwist(1, R)",
+		"Poke(1, L)",
+		"Unpoke(1)",
+		"Slide(a, 1)",
+		"Flip(1)",
+		"Untwist(1) Untwist(2",
+	} {
+		if _, err := knot.ParseMoves(s); !errors.Is(err, knot.InvalidMove) {
+			t.Errorf("#%d: ParseMoves(%q) returned error: %v; want: %v", i+1, s, err, knot.InvalidMove)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	for i, row := range []struct {
+		k     *knot.Knot
+		moves string
+		size  int
+	}{
+		{knot.Unknot(), "Twist(1, R) Twist(1, L) Untwist(1) Untwist(1)", 0},
+		{knot.Trefoil(), "Twist(1, R)", 4},
+		{knot.Trefoil(), "Twist(1, R) Untwist(2)", 3},
+		{knot.Trefoil(), "Poke(1, 2, L)", 5},
+		{knot.Trefoil(), "Poke(1, 2, L) Unpoke(3, 4)", 3},
+		{knot.Trefoil(), "Poke(3, 1, R) Twist(2, L)", 6},
+	} {
+		code, jones := row.k.ExtendedGaussCode(), row.k.Jones().String()
+		ms, err := knot.ParseMoves(row.moves)
+		if err != nil {
+			t.Fatalf("#%d: ParseMoves(%q) returned error: %v", i+1, row.moves, err)
+		}
+		if err := row.k.Apply(ms); err != nil {
+			t.Errorf("#%d: Apply(%q) returned error: %v", i+1, row.moves, err)
+			continue
+		}
+		if got := row.k.Size(); got != row.size {
+			t.Errorf("#%d: after Apply(%q): k.Size() = %d; want: %d", i+1, row.moves, got, row.size)
+		}
+		if got := row.k.Jones().String(); got != jones {
+			t.Errorf("#%d: after Apply(%q): k.Jones() = %q; want: %q", i+1, row.moves, got, jones)
+		}
+		if got := row.k.History().String(); got != row.moves {
+			t.Errorf("#%d: after Apply(%q): k.History() = %q; want: %q", i+1, row.moves, got, row.moves)
+		}
+
+		for range ms {
+			if !row.k.Undo() {
+				t.Errorf("#%d: after Apply(%q): k.Undo() = false; want: true", i+1, row.moves)
+			}
+		}
+		if row.k.Undo() {
+			t.Errorf("#%d: after undoing %q: k.Undo() = true; want: false", i+1, row.moves)
+		}
+		if got := row.k.ExtendedGaussCode(); got != code {
+			t.Errorf("#%d: after undoing %q: k.ExtendedGaussCode() = %q; want: %q", i+1, row.moves, got, code)
+		}
+	}
+}
+
+func TestApplyError(t *testing.T) {
+	for i, moves := range []string{
+		"Twist(5, R)",
+		"Untwist(1)",
+		"Twist(1, R) Untwist(1)",
+		"Poke(1, 2, L) Unpoke(1, 2)",
+		"Twist(2, L) Slide(1, 1)",
+		"Twist(1, R) Twist(1, L) Untwist(7)",
+	} {
+		k := knot.Trefoil()
+		k.Apply(knot.Moves{{Kind: knot.TwistMove, Arcs: []int{0}}})
+		code := k.ExtendedGaussCode()
+		ms, err := knot.ParseMoves(moves)
+		if err != nil {
+			t.Fatalf("#%d: ParseMoves(%q) returned error: %v", i+1, moves, err)
+		}
+		if err := k.Apply(ms); err == nil {
+			t.Errorf("#%d: Apply(%q) returned no error", i+1, moves)
+		}
+		if got := k.ExtendedGaussCode(); got != code {
+			t.Errorf("#%d: after Apply(%q): k.ExtendedGaussCode() = %q; want: %q", i+1, moves, got, code)
+		}
+		if got := len(k.History()); got != 1 {
+			t.Errorf("#%d: after Apply(%q): len(k.History()) = %d; want: 1", i+1, moves, got)
+		}
+	}
+}
+
+func TestApplySimplify(t *testing.T) {
+	// A trefoil with four crosses, which cannot be reduced without sliding.
+	const code = "O1- O2- U3- U1- O4- O3- U2- U4-"
+	k, err := knot.ParseGaussCode(code)
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+	_, moves := knot.Simplify(k, knot.SimplifyOptions{Depth: 2, Budget: 100})
+
+	// Replay the moves on a fresh copy of the diagram.
+	replay, err := knot.ParseGaussCode(code)
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+	ms, err := knot.ParseMoves(moves.String())
+	if err != nil {
+		t.Fatalf("ParseMoves(%q) returned error: %v", moves, err)
+	}
+	if err := replay.Apply(ms); err != nil {
+		t.Fatalf("Apply(%q) returned error: %v", ms, err)
+	}
+	if got, want := replay.ExtendedGaussCode(), k.ExtendedGaussCode(); got != want {
+		t.Errorf("after Apply(%q): k.ExtendedGaussCode() = %q; want: %q", ms, got, want)
+	}
+
+	// Undo the moves applied by Simplify().
+	for k.Undo() {
+	}
+	if got := k.ExtendedGaussCode(); got != code {
+		t.Errorf("after undoing Simplify(): k.ExtendedGaussCode() = %q; want: %q", got, code)
+	}
+}
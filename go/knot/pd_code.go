@@ -0,0 +1,84 @@
+package knot
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidPDCode indicates a malformed planar diagram code.
+var InvalidPDCode = errors.New("knot: invalid PD code")
+
+// PDCode returns the planar diagram code of the knot, as used by KnotTheory and SnapPy.
+// Edges between passing through crosses are labelled starting with 1, walking from the start of the first arc. Each
+// cross lists its four edges counter-clockwise, starting with the edge going in under the cross. Crosses are in the
+// same order as in Crosses().
+// If the diagram is not planar, nil is returned.
+func (k *Knot) PDCode() [][4]int {
+	d := k.diagram()
+	if len(d.faces()) != len(d.x)+2 && len(d.x) > 0 {
+		return nil
+	}
+
+	ret := make([][4]int, len(d.x))
+	for i, x := range d.x {
+		for j, e := range x.e {
+			ret[i][j] = e + 1
+		}
+	}
+
+	return ret
+}
+
+// FromPDCode creates a knot from its planar diagram code, as returned by Knot.PDCode().
+// Edges can be labelled arbitrarily, as long as each label is used exactly twice. The direction of the knot follows
+// the first edge, going in under the first cross, and the knot starts with the arc coming out under it. The handedness
+// of each cross depends on which end of the over strand goes in: crosses where it comes in from the right (the last
+// position) are right-handed.
+// If the code describes a link, or a diagram that is not planar, an error is returned.
+func FromPDCode(code [][4]int) (*Knot, error) {
+	if len(code) == 0 {
+		return Unknot(), nil
+	}
+
+	ends := map[int][][2]int{}
+	for i, x := range code {
+		for j, e := range x {
+			ends[e] = append(ends[e], [2]int{i, j})
+		}
+	}
+	twins := map[[2]int][2]int{}
+	for e, end := range ends {
+		if len(end) != 2 {
+			return nil, fmt.Errorf("%w: edge %d is used %d times instead of twice", InvalidPDCode, e, len(end))
+		}
+		twins[end[0]], twins[end[1]] = end[1], end[0]
+	}
+
+	// Walk along the knot, starting after the first cross and ending with the edge going in under it. This way, the
+	// knot starts with the arc coming out of the first cross.
+	cross, over := []int{}, []bool{}
+	crosses := make([]*Cross, len(code))
+	for end := twins[[2]int{0, 2}]; ; end = twins[[2]int{end[0], (end[1] + 2) % 4}] {
+		i, j := end[0], end[1]
+		if j == 2 {
+			return nil, fmt.Errorf("%w: direction of edge %d is inconsistent at cross %d", InvalidPDCode, code[i][j], i+1)
+		}
+		if j%2 == 1 {
+			crosses[i] = &Cross{Handedness: j == 3}
+		}
+		cross, over = append(cross, i), append(over, j%2 == 1)
+		if end == [2]int{0, 0} {
+			break
+		}
+	}
+	if len(cross) != 2*len(code) {
+		return nil, fmt.Errorf("%w: diagram has more than one component", InvalidPDCode)
+	}
+
+	k := gaussKnot(cross, over, crosses)
+	if len(k.diagram().faces()) != len(code)+2 {
+		return nil, NonPlanar
+	}
+
+	return k, nil
+}
@@ -0,0 +1,94 @@
+package knot_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestPDCode(t *testing.T) {
+	for i, row := range []struct {
+		k    *knot.Knot
+		want [][4]int
+	}{
+		{knot.Unknot(), [][4]int{}},
+		{knot.Trefoil(), [][4]int{{6, 3, 1, 4}, {2, 5, 3, 6}, {4, 1, 5, 2}}},
+		{knot.SimpleKnot(5), nil},
+	} {
+		if got, want := row.k.PDCode(), row.want; !reflect.DeepEqual(got, want) {
+			t.Errorf("#%d: PDCode() = %v; want: %v", i+1, got, want)
+		}
+	}
+}
+
+func TestFromPDCode(t *testing.T) {
+	for i, row := range []struct {
+		code  [][4]int
+		jones string
+	}{
+		{[][4]int{}, "1"},
+		{[][4]int{{1, 2, 2, 1}}, "1"},
+		{[][4]int{{1, 1, 2, 2}}, "1"},
+		// From KnotTheory.
+		{[][4]int{{1, 4, 2, 5}, {3, 6, 4, 1}, {5, 2, 6, 3}}, "x¯¹ + x¯³ - x¯⁴"},
+		{[][4]int{{4, 2, 5, 1}, {8, 6, 1, 5}, {6, 3, 7, 4}, {2, 7, 3, 8}}, "x² - x + 1 - x¯¹ + x¯²"},
+		// Mirror image, labelled starting with 0.
+		{[][4]int{{0, 4, 1, 3}, {2, 0, 3, 5}, {4, 2, 5, 1}}, "-x⁴ + x³ + x"},
+	} {
+		k, err := knot.FromPDCode(row.code)
+		if err != nil {
+			t.Errorf("#%d: FromPDCode(%v) returned error: %v", i+1, row.code, err)
+			continue
+		}
+		if got, want := k.Size(), len(row.code); got != want {
+			t.Errorf("#%d: FromPDCode(%v).Size() = %d; want: %d", i+1, row.code, got, want)
+		}
+		if got, want := k.Jones().String(), row.jones; got != want {
+			t.Errorf("#%d: FromPDCode(%v).Jones() = %q; want: %q", i+1, row.code, got, want)
+		}
+	}
+}
+
+func TestFromPDCodeRoundTrip(t *testing.T) {
+	ks := []*knot.Knot{knot.Trefoil()}
+	for _, code := range [][]int{{4, 6, 8, 2}, {6, 8, 10, 2, 4}, {4, 8, -12, 2, -14, -16, -6, -10}} {
+		k, err := knot.FromDTCode(code)
+		if err != nil {
+			t.Fatalf("FromDTCode(%v) returned error: %v", code, err)
+		}
+		ks = append(ks, k)
+	}
+
+	for i, k := range ks {
+		code := k.PDCode()
+		got, err := knot.FromPDCode(code)
+		if err != nil {
+			t.Errorf("#%d: FromPDCode(%v) returned error: %v", i+1, code, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.PDCode(), code) || got.String() != k.String() {
+			t.Errorf("#%d: FromPDCode(%v) = %v; want: %v", i+1, code, got, k)
+		}
+	}
+}
+
+func TestFromPDCodeError(t *testing.T) {
+	for i, row := range []struct {
+		code [][4]int
+		err  error
+	}{
+		{[][4]int{{1, 2, 3, 4}}, knot.InvalidPDCode},
+		{[][4]int{{1, 1, 1, 2}}, knot.InvalidPDCode},
+		{[][4]int{{1, 2, 1, 2}}, knot.InvalidPDCode},
+		// Hopf link.
+		{[][4]int{{4, 1, 3, 2}, {2, 3, 1, 4}}, knot.InvalidPDCode},
+		// Virtual trefoil.
+		{[][4]int{{2, 1, 3, 4}, {1, 4, 2, 3}}, knot.NonPlanar},
+	} {
+		if _, err := knot.FromPDCode(row.code); !errors.Is(err, row.err) {
+			t.Errorf("#%d: FromPDCode(%v) error = %v; want: %v", i+1, row.code, err, row.err)
+		}
+	}
+}
@@ -3,10 +3,20 @@ package knot
 import "errors"
 
 var (
+	PokeError    = errors.New("knot: cannot poke arc: must share a face with the other arc")
 	SlideError   = errors.New("knot: cannot slide arc: must be next to the cross")
+	UnpokeError  = errors.New("knot: cannot unpoke crosses: must form a bigon")
 	UntwistError = errors.New("knot: cannot untwist cross: arc must cross itself")
 )
 
+// A Reduction is a Reidemeister move that removes crosses from the diagram.
+type Reduction struct {
+	// Move is 1 for the first Reidemeister move, undone by Untwist(), or 2 for the second one, undone by Unpoke().
+	Move int
+	// Crosses to remove: one for the first move, two for the second, in order along the arc going under.
+	Crosses []*Cross
+}
+
 // Twist performs the first Reidemeister move.
 // The resulting new cross is returned for convenience.
 func Twist(a *Arc, h Handedness) *Cross {
@@ -15,6 +25,7 @@ func Twist(a *Arc, h Handedness) *Cross {
 		c := Cross{In: a, Out: a, Over: a, Handedness: h}
 		a.Start = &c
 		a.Stop = &c
+		a.Over = []*Cross{&c}
 
 		return &c
 	}
@@ -23,8 +34,10 @@ func Twist(a *Arc, h Handedness) *Cross {
 	c.Out = &Arc{Start: &c, Stop: a.Stop}
 	if h == Right {
 		c.Over = c.Out
+		c.Out.Over = []*Cross{&c}
 	} else {
 		c.Over = a
+		a.Over = append(a.Over, &c)
 	}
 	a.Stop.In = c.Out
 	a.Stop = &c
@@ -38,18 +51,40 @@ func TwistLeft(a *Arc) *Cross { return Twist(a, Left) }
 // TwistRight is a shortcut for calling Twist with right handedness.
 func TwistRight(a *Arc) *Cross { return Twist(a, Right) }
 
-// Untwist undoes the Twist() operation.
-func Untwist(c *Cross) error {
-	if c.Over == c.In {
-		// Untwist Twist(a, over=true)
-		c.Over.Stop = c.Out.Stop
-	} else if c.Over == c.Out {
-		// Untwist Twist(a, over=false)
-		c.Over.Start = c.In.Start
-	} else {
+// Untwist undoes the first Reidemeister move, removing a cross where an arc crosses itself without crossing anything
+// else in between. It is the inverse of Twist(): the arc going in to the cross is kept, and the one going out of it is
+// merged into it. A single twist of the unknot is undone by removing the only cross. If the knot starts at the arc
+// going out, it is moved to the arc going in.
+// If the cross cannot be untwisted, UntwistError is returned.
+func (k *Knot) Untwist(c *Cross) error {
+	if !isTwist(c) {
 		return UntwistError
 	}
 
+	a, b := c.In, c.Out
+	if k.start == b {
+		k.start = a
+	}
+	if a == b {
+		// Unknot with a single cross.
+		a.Start, a.Stop, a.Over = nil, nil, nil
+		return nil
+	}
+
+	if c.Over == a {
+		// As made by Twist(a, Left): the arc going in goes over the cross last.
+		a.Over = a.Over[:len(a.Over)-1]
+	} else {
+		// As made by Twist(a, Right): the arc going out goes over the cross first.
+		b.Over = b.Over[1:]
+	}
+	for _, x := range b.Over {
+		x.Over = a
+	}
+	a.Over = append(a.Over, b.Over...)
+	a.Stop = b.Stop
+	a.Stop.In = a
+
 	return nil
 }
 
@@ -57,6 +92,7 @@ func Untwist(c *Cross) error {
 // The first parameter, 'over', ends up going over in both crosses.
 // Note that no validation is done on whether the poke is a possible move, i.e. whether there is another arc that
 // separates the two args passed in as parameters. The resulting two new crosses are returned for convenience.
+// See PokeFrom() for a variant that checks whether the move is possible.
 func Poke(over, under *Arc) (*Cross, *Cross) {
 	c1 := Cross{Over: over, In: under}
 	c2 := Cross{Over: over}
@@ -65,33 +101,225 @@ func Poke(over, under *Arc) (*Cross, *Cross) {
 	c2.Out = &Arc{Start: &c2, Stop: under.Stop}
 	under.Stop.In = c2.Out
 	under.Stop = &c1
+	over.Over = append(over.Over, &c1, &c2)
+
+	return &c1, &c2
+}
+
+// PokeFrom performs the second Reidemeister move, pushing a part of the arc 'under' out on its left or right side,
+// until it goes under the arc 'over', making two new crosses.
+// The two arcs must share a face on that side of 'under', otherwise PokeError is returned. The first edge of 'under'
+// next to such a face is poked under the first edge of 'over' around the same face. The arcs can be the same, if two
+// different edges of the arc bound the face. Unlike Poke(), the handedness of
+// the new crosses is set according to the direction of the arcs, so the diagram stays planar.
+// If the diagram is not planar, NonPlanar is returned. The two new crosses are returned in order along 'under'.
+func PokeFrom(over, under *Arc, left bool) (*Cross, *Cross, error) {
+	faces, err := (&Knot{start: under}).Faces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := 0; i <= len(under.Over); i++ {
+		for _, f := range faces {
+			if !f.has(Side{Edge{under, i}, left}) {
+				continue
+			}
+			for _, s := range f.Sides {
+				if s.Edge.Arc == over && s.Edge != (Edge{under, i}) {
+					// The first new cross is right-handed if the face is on the right of 'over'.
+					c1, c2 := poke(s.Edge, Edge{under, i}, Handedness(!s.Left), left != s.Left)
+					return c1, c2, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil, PokeError
+}
+
+// Unpoke undoes the second Reidemeister move, removing two crosses that form a bigon: the same arc goes over both, and
+// the arc going under connects them directly. The crosses can be passed in either order.
+// The arcs going over and in to the first cross along the arc going under are kept, the ones going out of the crosses
+// are merged into the latter. If the knot starts at one of the arcs removed, it is moved to the arc going in.
+// If the crosses do not form a bigon, UnpokeError is returned.
+func (k *Knot) Unpoke(c1, c2 *Cross) error {
+	if !isBigon(c1, c2) {
+		c1, c2 = c2, c1
+		if !isBigon(c1, c2) {
+			return UnpokeError
+		}
+	}
+
+	o := c1.Over
+	i := min(indexOf(o.Over, c1), indexOf(o.Over, c2))
+	o.Over = append(o.Over[:i:i], o.Over[i+2:]...)
+
+	a, b := c1.In, c2.Out
+	if k.start == c1.Out || k.start == b {
+		k.start = a
+	}
+	if a == b {
+		// There were no other crosses, only the unknot is left.
+		a.Start, a.Stop, a.Over = nil, nil, nil
+		return nil
+	}
+	for _, c := range b.Over {
+		c.Over = a
+	}
+	a.Over = append(a.Over, b.Over...)
+	a.Stop = b.Stop
+	a.Stop.In = a
+
+	return nil
+}
+
+// poke pokes the edge 'under' under the edge 'over', in between the crosses at their ends.
+// The first new cross along 'under' has handedness 'h', the second one the opposite. If 'forward' is set, the arc going
+// over reaches the first new cross first. The edges can be on the same arc.
+func poke(over, under Edge, h Handedness, forward bool) (*Cross, *Cross) {
+	c1 := Cross{In: under.Arc, Handedness: h}
+	c2 := Cross{Handedness: !h}
+
+	// The arc going under is split in three, keeping the crosses it goes over after the edge for the last part.
+	a := under.Arc
+	c1.Out = &Arc{Start: &c1, Stop: &c2}
+	c2.In = c1.Out
+	c2.Out = &Arc{Start: &c2, Stop: a.Stop, Over: append([]*Cross{}, a.Over[under.Index:]...)}
+	for _, c := range c2.Out.Over {
+		c.Over = c2.Out
+	}
+	a.Stop.In = c2.Out
+	a.Stop = &c1
+	a.Over = a.Over[:under.Index]
+	if over.Arc == a && over.Index > under.Index {
+		// The edge going over is now on the last part of the arc.
+		over = Edge{c2.Out, over.Index - under.Index}
+	}
+
+	crosses := []*Cross{&c1, &c2}
+	if !forward {
+		crosses[0], crosses[1] = crosses[1], crosses[0]
+	}
+	o := over.Arc
+	c1.Over, c2.Over = o, o
+	o.Over = append(o.Over[:over.Index], append(crosses, o.Over[over.Index:]...)...)
 
 	return &c1, &c2
 }
 
 // Slide performs the third Reidemeister move.
-// Repeating the same operation twice undoes the slide.
+// The arc 'a' must go under two arcs that also cross each other at 'c', and the three crosses must bound a triangle.
+// The arc is moved to the other side of 'c'. Repeating the same operation twice undoes the slide.
 func Slide(a *Arc, c *Cross) error {
-	if (a.Start.Over == c.Over) && (a.Stop.Over == c.In || a.Stop.Over == c.Out) {
-		slideCrosses(c, a.Stop, a.Start)
-	} else if (a.Start.Over == c.In || a.Start.Over == c.Out) && (a.Stop.Over == c.Over) {
-		slideCrosses(c, a.Start, a.Stop)
-	} else {
+	if len(a.Over) != 0 || a.Start == nil || a.Start == a.Stop || a.Start == c || a.Stop == c {
 		return SlideError
 	}
 
+	top, bottom := a.Start, a.Stop
+	if !canSlide(a, c, top, bottom) {
+		top, bottom = bottom, top
+		if !canSlide(a, c, top, bottom) {
+			return SlideError
+		}
+	}
+
+	from, to := c.In, c.Out
+	if bottom.Over == c.Out {
+		from, to = to, from
+	}
+
+	// The two crosses along the arc swap places.
+	i, j := indexOf(c.Over.Over, c), indexOf(c.Over.Over, top)
+	top.Over, top.Handedness, bottom.Over, bottom.Handedness = to, bottom.Handedness, c.Over, top.Handedness
+	c.Over.Over[i], c.Over.Over[j] = bottom, c
+	if from == c.In {
+		from.Over = from.Over[:len(from.Over)-1]
+		to.Over = append([]*Cross{top}, to.Over...)
+	} else {
+		from.Over = from.Over[1:]
+		to.Over = append(to.Over, top)
+	}
+
 	return nil
 }
 
-// Slide using three crosses. Parameters are:
-// c1: top + middle arc.
-// c2: top + bottom arc.
-// c3: middle + bottom arc.
-func slideCrosses(c1, c2, c3 *Cross) {
-	c3.Over = c1.Over
-	if c2.Over == c1.In {
-		c2.Over = c1.Out
-	} else {
-		c2.Over = c1.In
+// canSlide checks whether the arc 'a' going under at 'top' and 'bottom' can slide over 'c'.
+// At 'top', the arc must go under the same arc that goes over at 'c'. At 'bottom', it must go under the arc going
+// in to or out of 'c'. The three crosses must be next to each other along the arcs going over them, and they must
+// bound a triangle, i.e. walking around them must turn the same way at each cross.
+func canSlide(a *Arc, c, top, bottom *Cross) bool {
+	if top.Over != c.Over {
+		return false
+	}
+	i, j := indexOf(c.Over.Over, c), indexOf(c.Over.Over, top)
+	if j < 0 || (i-j != 1 && j-i != 1) {
+		return false
+	}
+
+	var in bool
+	switch bottom.Over {
+	case c.In:
+		in = true
+		if c.In.Over[len(c.In.Over)-1] != bottom {
+			return false
+		}
+	case c.Out:
+		if c.Out.Over[0] != bottom {
+			return false
+		}
+	default:
+		return false
 	}
+
+	// Walk from 'c' to 'top' along the arc going over, then to 'bottom' along 'a', then back to 'c'.
+	forward := a.Start == top
+	turns := [...]int{
+		c.slot(true, j > i) - c.slot(false, !in),
+		top.slot(false, forward) - top.slot(true, j < i),
+		bottom.slot(true, in) - bottom.slot(false, !forward),
+	}
+	for i, t := range turns {
+		turns[i] = (t + 4) % 4
+	}
+
+	return turns[0] == turns[1] && turns[1] == turns[2]
+}
+
+// Reductions lists the Reidemeister moves that can remove crosses from the diagram, in order of the first cross.
+// Crosses that can be untwisted are listed for the first move, and pairs of crosses forming a bigon for the second.
+func (k *Knot) Reductions() []Reduction {
+	ret := []Reduction{}
+	for _, c := range k.Crosses() {
+		if isTwist(c) {
+			ret = append(ret, Reduction{1, []*Cross{c}})
+		}
+		if next := c.Out.Stop; isBigon(c, next) {
+			ret = append(ret, Reduction{2, []*Cross{c, next}})
+		}
+	}
+
+	return ret
+}
+
+// isBigon reports whether the crosses can be removed by the second Reidemeister move: the arc going out under 'c1' must
+// go under 'c2' next, the same arc must go over both of them, one right after the other, and their handedness must be
+// different.
+func isBigon(c1, c2 *Cross) bool {
+	if c1 == c2 || c1.Out != c2.In || len(c1.Out.Over) != 0 || c1.Over != c2.Over || c1.Handedness == c2.Handedness {
+		return false
+	}
+	i, j := indexOf(c1.Over.Over, c1), indexOf(c1.Over.Over, c2)
+
+	return i-j == 1 || j-i == 1
+}
+
+// indexOf returns the position of 'c' in 'crosses', or -1 if not found.
+func indexOf(crosses []*Cross, c *Cross) int {
+	for i, x := range crosses {
+		if x == c {
+			return i
+		}
+	}
+
+	return -1
 }
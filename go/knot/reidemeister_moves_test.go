@@ -0,0 +1,453 @@
+package knot_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestOver(t *testing.T) {
+	k := knot.Trefoil()
+	knot.TwistLeft(k.Arcs()[0])
+	knot.TwistRight(k.Arcs()[1])
+	knot.Poke(k.Arcs()[3], k.Arcs()[0])
+	if got, want := k.String(), "L1 A1{L7, L4} L2 A2 L3 A3 L4 A4 R5 A5{R5} L6 A6{L1, L2, L3} L7 A7{L6} L1"; got != want {
+		t.Errorf("k.String() = %q; want: %q", got, want)
+	}
+
+	for rev := 0; rev < 2; rev++ {
+		// Each cross is listed once, by the arc going over it.
+		seen := map[*knot.Cross]bool{}
+		for i, a := range k.Arcs() {
+			for j, c := range a.Over {
+				if c.Over != a || seen[c] {
+					t.Errorf("(rev %d): k.Arcs()[%d].Over[%d] is not a cross over the arc", rev, i, j)
+				}
+				seen[c] = true
+			}
+		}
+		if got, want := len(seen), k.Size(); got != want {
+			t.Errorf("(rev %d): crosses listed in Arc.Over: %d; want: %d", rev, got, want)
+		}
+
+		// Reversing the knot reverses the order of the crosses along each arc.
+		k.Reverse()
+	}
+}
+
+func TestSlide(t *testing.T) {
+	// An unknot with three twists, forming a triangle in the middle.
+	k := knot.Unknot()
+	knot.TwistRight(k.Arcs()[0])
+	knot.TwistLeft(k.Arcs()[0])
+	knot.TwistLeft(k.Arcs()[1])
+
+	before := k.String()
+	if want := "R1 A1{R1, L2} L2 A2{L3} L3 A3 R1"; before != want {
+		t.Fatalf("k.String() = %q; want: %q", before, want)
+	}
+
+	a, c := k.Arcs()[2], k.Crosses()[1]
+	if err := knot.Slide(a, c); err != nil {
+		t.Fatalf("Slide() returned error: %v", err)
+	}
+	if got, want := k.String(), "L1 A1{L2, R3, L1} L2 A2 R3 A3 L1"; got != want {
+		t.Errorf("after Slide(): k.String() = %q; want: %q", got, want)
+	}
+	if got, want := k.Jones().String(), "1"; got != want {
+		t.Errorf("after Slide(): k.Jones() = %q; want: %q", got, want)
+	}
+
+	if err := knot.Slide(a, c); err != nil {
+		t.Fatalf("second Slide() returned error: %v", err)
+	}
+	if got, want := k.String(), before; got != want {
+		t.Errorf("after second Slide(): k.String() = %q; want: %q", got, want)
+	}
+
+	// Crosses of the trefoil are alternating, it has no triangle to slide across.
+	k = knot.Trefoil()
+	for i, a := range k.Arcs() {
+		for j, c := range k.Crosses() {
+			if err := knot.Slide(a, c); err != knot.SlideError {
+				t.Errorf("Trefoil(): Slide(arc %d, cross %d) = %v; want: %v", i+1, j+1, err, knot.SlideError)
+			}
+		}
+	}
+}
+
+func TestPokeFrom(t *testing.T) {
+	for i, left := range []bool{true, false} {
+		k := knot.Trefoil()
+		jones, writhe := k.Jones().String(), k.Writhe()
+		arcs := k.Arcs()
+		c1, c2, err := knot.PokeFrom(arcs[0], arcs[1], left)
+		if err != nil {
+			t.Errorf("#%d: PokeFrom() returned error: %v", i+1, err)
+			continue
+		}
+		if c1.Over != arcs[0] || c2.Over != arcs[0] || c1.In != arcs[1] || c1.Out != c2.In {
+			t.Errorf("#%d: PokeFrom() returned unexpected crosses", i+1)
+		}
+		if c1.Handedness == c2.Handedness {
+			t.Errorf("#%d: PokeFrom() returned crosses with the same handedness", i+1)
+		}
+		if _, err := k.Faces(); err != nil {
+			t.Errorf("#%d: after PokeFrom(): Faces() returned error: %v", i+1, err)
+		}
+		if got := k.Size(); got != 5 {
+			t.Errorf("#%d: after PokeFrom(): k.Size() = %d; want: 5", i+1, got)
+		}
+		if got := k.Writhe(); got != writhe {
+			t.Errorf("#%d: after PokeFrom(): k.Writhe() = %d; want: %d", i+1, got, writhe)
+		}
+		if got := k.Jones().String(); got != jones {
+			t.Errorf("#%d: after PokeFrom(): k.Jones() = %q; want: %q", i+1, got, jones)
+		}
+	}
+}
+
+func TestPokeFromSelf(t *testing.T) {
+	// An unknot with two twists, where each arc goes over the cross at its own end, bounding the same face twice.
+	const code = "O1- U1- O2- U2-"
+	for i, row := range []struct {
+		arc  int
+		left bool
+	}{
+		{0, true},
+		{0, false},
+		{1, true},
+		{1, false},
+	} {
+		k, err := knot.ParseGaussCode(code)
+		if err != nil {
+			t.Fatalf("ParseGaussCode() returned error: %v", err)
+		}
+		a := k.Arcs()[row.arc]
+		c1, c2, err := knot.PokeFrom(a, a, row.left)
+		if err != nil {
+			t.Errorf("#%d: PokeFrom() returned error: %v", i+1, err)
+			continue
+		}
+		if c1.Over != c2.Over || c1.Out != c2.In || c1.Handedness == c2.Handedness {
+			t.Errorf("#%d: PokeFrom() returned unexpected crosses", i+1)
+		}
+		if _, err := k.Faces(); err != nil {
+			t.Errorf("#%d: after PokeFrom(): Faces() returned error: %v", i+1, err)
+		}
+		if got := k.Size(); got != 4 {
+			t.Errorf("#%d: after PokeFrom(): k.Size() = %d; want: 4", i+1, got)
+		}
+		if got := k.Jones().String(); got != "1" {
+			t.Errorf("#%d: after PokeFrom(): k.Jones() = %q; want: %q", i+1, got, "1")
+		}
+		if err := k.Unpoke(c1, c2); err != nil {
+			t.Errorf("#%d: Unpoke() returned error: %v", i+1, err)
+		}
+		if got := k.ExtendedGaussCode(); got != code {
+			t.Errorf("#%d: after Unpoke(): k.ExtendedGaussCode() = %q; want: %q", i+1, got, code)
+		}
+	}
+}
+
+func TestPokeFromError(t *testing.T) {
+	trefoil := knot.Trefoil()
+	// In this diagram of 6₁, the second and fourth arcs are not next to the same face.
+	k := knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure()
+	nonPlanar := knot.SimpleKnot(5)
+
+	for i, row := range []struct {
+		over, under *knot.Arc
+		err         error
+	}{
+		{trefoil.Arcs()[0], trefoil.Arcs()[0], knot.PokeError},
+		{trefoil.Arcs()[0], knot.Unknot().Arcs()[0], knot.PokeError},
+		{k.Arcs()[1], k.Arcs()[3], knot.PokeError},
+		{nonPlanar.Arcs()[0], nonPlanar.Arcs()[1], knot.NonPlanar},
+	} {
+		for _, left := range []bool{true, false} {
+			if _, _, err := knot.PokeFrom(row.over, row.under, left); !errors.Is(err, row.err) {
+				t.Errorf("#%d: PokeFrom(left: %v) error = %v; want: %v", i+1, left, err, row.err)
+			}
+		}
+	}
+	if got := k.Size(); got != 7 {
+		t.Errorf("after PokeFrom(): k.Size() = %d; want: 7", got)
+	}
+}
+
+func TestUnpoke(t *testing.T) {
+	for i, left := range []bool{true, false} {
+		for j, swap := range []bool{false, true} {
+			k := knot.Trefoil()
+			before := k.String()
+			arcs := k.Arcs()
+			c1, c2, err := knot.PokeFrom(arcs[2], arcs[0], left)
+			if err != nil {
+				t.Fatalf("#%d.%d: PokeFrom() returned error: %v", i+1, j+1, err)
+			}
+			if swap {
+				c1, c2 = c2, c1
+			}
+			if err := k.Unpoke(c1, c2); err != nil {
+				t.Errorf("#%d.%d: Unpoke() returned error: %v", i+1, j+1, err)
+			}
+			if got := k.String(); got != before {
+				t.Errorf("#%d.%d: after Unpoke(): k.String() = %q; want: %q", i+1, j+1, got, before)
+			}
+		}
+	}
+
+	// Unknot with two crosses, forming a bigon.
+	k, err := knot.ParseGaussCode("O1+ O2- U2- U1+")
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+	crosses := k.Crosses()
+	if err := k.Unpoke(crosses[0], crosses[1]); err != nil {
+		t.Errorf("Unpoke() returned error: %v", err)
+	}
+	if got, want := k.String(), knot.Unknot().String(); got != want {
+		t.Errorf("after Unpoke(): k.String() = %q; want: %q", got, want)
+	}
+}
+
+func TestUnpokeStart(t *testing.T) {
+	k := knot.Trefoil()
+	arcs := k.Arcs()
+	if _, _, err := knot.PokeFrom(arcs[0], arcs[1], true); err != nil {
+		t.Fatalf("PokeFrom() returned error: %v", err)
+	}
+
+	// Start the knot at each of its arcs, including the ones removed by Unpoke().
+	for i, code := range rotations(k.ExtendedGaussCode()) {
+		k, err := knot.ParseGaussCode(code)
+		if err != nil {
+			t.Fatalf("#%d: ParseGaussCode(%q) returned error: %v", i+1, code, err)
+		}
+		var bigon []*knot.Cross
+		for _, r := range k.Reductions() {
+			if r.Move == 2 {
+				bigon = r.Crosses
+			}
+		}
+		if bigon == nil {
+			t.Fatalf("#%d: %q: Reductions() found no bigon", i+1, code)
+		}
+		if err := k.Unpoke(bigon[0], bigon[1]); err != nil {
+			t.Errorf("#%d: %q: Unpoke() returned error: %v", i+1, code, err)
+		}
+		if got, want := len(k.Arcs()), 3; got != want {
+			t.Errorf("#%d: %q: after Unpoke(): len(k.Arcs()) = %d; want: %d", i+1, code, got, want)
+		}
+		if !k.Equal(knot.Trefoil()) {
+			t.Errorf("#%d: %q: after Unpoke(): k = %q; want a trefoil", i+1, code, k)
+		}
+	}
+}
+
+func TestUnpokeError(t *testing.T) {
+	k := knot.Trefoil()
+	before := k.String()
+	crosses := k.Crosses()
+	for i, c1 := range crosses {
+		for j, c2 := range crosses {
+			if err := k.Unpoke(c1, c2); err != knot.UnpokeError {
+				t.Errorf("Unpoke(cross %d, cross %d) = %v; want: %v", i+1, j+1, err, knot.UnpokeError)
+			}
+		}
+	}
+	if got := k.String(); got != before {
+		t.Errorf("after Unpoke(): k.String() = %q; want: %q", got, before)
+	}
+}
+
+func TestReductions(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[2])
+	poked := knot.Trefoil()
+	if _, _, err := knot.PokeFrom(poked.Arcs()[2], poked.Arcs()[0], true); err != nil {
+		t.Fatalf("PokeFrom() returned error: %v", err)
+	}
+	// Unknot with two crosses, both of which can be untwisted, forming a bigon.
+	bigon, err := knot.ParseGaussCode("O1+ O2- U2- U1+")
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+
+	for i, row := range []struct {
+		k *knot.Knot
+		// Moves, and the positions of the crosses in Crosses().
+		moves   []int
+		crosses [][]int
+	}{
+		{knot.Unknot(), []int{}, [][]int{}},
+		{knot.Trefoil(), []int{}, [][]int{}},
+		{twisted, []int{1, 1}, [][]int{{1}, {3}}},
+		// Poking makes two bigons: one between the new crosses, one with an old cross.
+		{poked, []int{2, 2}, [][]int{{1, 2}, {2, 3}}},
+		{bigon, []int{1, 1, 2}, [][]int{{0}, {1}, {1, 0}}},
+	} {
+		index := map[*knot.Cross]int{}
+		for j, c := range row.k.Crosses() {
+			index[c] = j
+		}
+		moves, crosses := []int{}, [][]int{}
+		for _, r := range row.k.Reductions() {
+			moves = append(moves, r.Move)
+			pos := []int{}
+			for _, c := range r.Crosses {
+				pos = append(pos, index[c])
+			}
+			crosses = append(crosses, pos)
+		}
+		if !reflect.DeepEqual(moves, row.moves) || !reflect.DeepEqual(crosses, row.crosses) {
+			t.Errorf("#%d: Reductions() = %v, %v; want: %v, %v", i+1, moves, crosses, row.moves, row.crosses)
+		}
+	}
+}
+
+func TestUntwist(t *testing.T) {
+	knots := []func() *knot.Knot{
+		knot.Unknot,
+		knot.Trefoil,
+		knot.FigureEight,
+		func() *knot.Knot { return knot.Braid{3, []int{1, -2, 1, -2}}.Closure() },
+		func() *knot.Knot {
+			k := knot.Trefoil()
+			knot.TwistLeft(k.Arcs()[1])
+			knot.TwistRight(k.Arcs()[1])
+			return k
+		},
+	}
+	for i, fn := range knots {
+		for j := range fn().Arcs() {
+			for _, h := range []knot.Handedness{knot.Left, knot.Right} {
+				k := fn()
+				arcs := k.Arcs()
+				before := map[*knot.Arc]knot.Arc{}
+				for _, a := range arcs {
+					before[a] = knot.Arc{Start: a.Start, Stop: a.Stop, Over: append([]*knot.Cross{}, a.Over...)}
+				}
+				crosses := map[*knot.Cross]knot.Cross{}
+				for _, c := range k.Crosses() {
+					crosses[c] = *c
+				}
+				s := k.String()
+
+				c := knot.Twist(arcs[j], h)
+				if err := k.Untwist(c); err != nil {
+					t.Errorf("#%d: Untwist(Twist(arc %d, %s)) returned error: %v", i+1, j+1, h, err)
+					continue
+				}
+				if got := k.String(); got != s {
+					t.Errorf("#%d: after Untwist(Twist(arc %d, %s)): k.String() = %q; want: %q", i+1, j+1, h, got, s)
+				}
+				for a, want := range before {
+					if a.Start != want.Start || a.Stop != want.Stop || !reflect.DeepEqual(append([]*knot.Cross{}, a.Over...), want.Over) {
+						t.Errorf("#%d: after Untwist(Twist(arc %d, %s)): arc changed", i+1, j+1, h)
+					}
+				}
+				for c, want := range crosses {
+					if *c != want {
+						t.Errorf("#%d: after Untwist(Twist(arc %d, %s)): cross changed", i+1, j+1, h)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestUntwistStart(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistLeft(twisted.Arcs()[1])
+	loop := knot.Unknot()
+	knot.TwistLeft(loop.Arcs()[0])
+
+	// Start the knots at each of their arcs, including the ones removed by Untwist().
+	for i, row := range []struct {
+		code string
+		want *knot.Knot
+	}{
+		{"O1- U1- O2- U2-", loop},
+		{twisted.ExtendedGaussCode(), knot.Trefoil()},
+	} {
+		for j, code := range rotations(row.code) {
+			k, err := knot.ParseGaussCode(code)
+			if err != nil {
+				t.Fatalf("#%d.%d: ParseGaussCode(%q) returned error: %v", i+1, j+1, code, err)
+			}
+			for _, c := range k.Crosses() {
+				if c.In != c.Over && c.Out != c.Over {
+					continue
+				}
+				if err := k.Untwist(c); err != nil {
+					t.Errorf("#%d.%d: %q: Untwist() returned error: %v", i+1, j+1, code, err)
+				}
+				break
+			}
+			if got, want := len(k.Arcs()), len(row.want.Arcs()); got != want {
+				t.Errorf("#%d.%d: %q: after Untwist(): len(k.Arcs()) = %d; want: %d", i+1, j+1, code, got, want)
+			}
+			if !k.Equal(row.want) {
+				t.Errorf("#%d.%d: %q: after Untwist(): k = %q; want: %q", i+1, j+1, code, k, row.want)
+			}
+		}
+	}
+}
+
+func TestUntwistReductions(t *testing.T) {
+	k := knot.Trefoil()
+	knot.TwistLeft(k.Arcs()[0])
+	knot.TwistRight(k.Arcs()[2])
+	knot.TwistRight(k.Arcs()[2])
+	for _, r := range k.Reductions() {
+		if err := k.Untwist(r.Crosses[0]); err != nil {
+			t.Errorf("Untwist() returned error: %v", err)
+		}
+	}
+	if got, want := k.String(), knot.Trefoil().String(); got != want {
+		t.Errorf("after Untwist(): k.String() = %q; want: %q", got, want)
+	}
+
+	u, err := knot.ParseGaussCode("O1 U1")
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+	if err := u.Untwist(u.Crosses()[0]); err != nil {
+		t.Errorf("Untwist() returned error: %v", err)
+	}
+	if a := u.Arcs()[0]; a.Start != nil || a.Stop != nil || len(a.Over) != 0 {
+		t.Errorf("after Untwist(): arc = %+v; want: %+v", *a, knot.Arc{})
+	}
+}
+
+func TestUntwistError(t *testing.T) {
+	k := knot.Trefoil()
+	before := k.String()
+	for i, c := range k.Crosses() {
+		if err := k.Untwist(c); err != knot.UntwistError {
+			t.Errorf("Untwist(cross %d) = %v; want: %v", i+1, err, knot.UntwistError)
+		}
+	}
+	if got := k.String(); got != before {
+		t.Errorf("after Untwist(): k.String() = %q; want: %q", got, before)
+	}
+}
+
+// rotations returns the extended Gauss code starting at each arc, i.e. after each pass going under.
+func rotations(code string) []string {
+	passes := strings.Fields(code)
+	ret := []string{}
+	for i := range passes {
+		if strings.HasPrefix(passes[(i+len(passes)-1)%len(passes)], "U") {
+			ret = append(ret, strings.Join(append(append([]string{}, passes[i:]...), passes[:i]...), " "))
+		}
+	}
+
+	return ret
+}
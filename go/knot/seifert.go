@@ -0,0 +1,347 @@
+package knot
+
+import (
+	"github.com/attilaolah/math/go/poly"
+)
+
+// SeifertCircles returns the number of Seifert circles, i.e. the loops left after smoothing each cross along the
+// orientation of the knot.
+func (k *Knot) SeifertCircles() int {
+	d := k.diagram()
+	return len(d.seifert()) + d.loops
+}
+
+// SeifertGenus returns the genus of the canonical Seifert surface of the diagram, built by Seifert's algorithm: a
+// disk for each Seifert circle, joined by a half-twisted band at each cross.
+// For c crosses and s circles, this is (c - s + 1) / 2, an upper bound on the genus of the knot.
+// If the diagram is not planar, -1 is returned.
+func (k *Knot) SeifertGenus() int {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return 0
+	}
+	if len(d.faces()) != len(d.x)+2 {
+		return -1
+	}
+
+	return (len(d.x) - len(d.seifert()) + 1) / 2
+}
+
+// SeifertMatrix generates a Seifert matrix of the canonical Seifert surface of the diagram, see SeifertGenus().
+// The surface has a disk for each Seifert circle, stacked above the disk of the circle around it, and a half-twisted
+// band at each cross. Rows and columns correspond to loops going around the cycles of the Seifert graph, one for each
+// cross not in a spanning tree, so the matrix is 2g×2g for a surface of genus g. Each element is the linking number of
+// the loop of its row with the loop of its column, pushed off the surface in the positive direction.
+// The Alexander polynomial is det(V - tVᵀ), and the signature is that of V + Vᵀ.
+// If the Seifert surface is a disk, or the diagram is not planar, nil is returned.
+func (k *Knot) SeifertMatrix() *poly.Int64M {
+	d := k.diagram()
+	if len(d.x) == 0 || len(d.faces()) != len(d.x)+2 {
+		return nil
+	}
+
+	return d.seifertMatrix()
+}
+
+// A band joins two Seifert circles at a cross: the one through the incoming under-edge, and the one through the
+// incoming over-edge, at the given positions along them.
+type band struct {
+	under, over       int
+	underPos, overPos int
+}
+
+// A seifertStep is part of a loop on the Seifert surface, going through the band at a cross to the given circle.
+type seifertStep struct{ cross, circle int }
+
+// seifertMatrix generates the Seifert matrix of a planar diagram with at least one cross.
+func (d *diagram) seifertMatrix() *poly.Int64M {
+	circles := d.seifert()
+	circle, pos := map[int]int{}, map[int]int{}
+	for i, edges := range circles {
+		for j, e := range edges {
+			circle[e], pos[e] = i, j
+		}
+	}
+	bands := make([]band, len(d.x))
+	for i, x := range d.x {
+		in, _, overIn, _ := x.edges()
+		bands[i] = band{circle[in], circle[overIn], pos[in], pos[overIn]}
+	}
+	ccw := d.seifertOrientation(circles)
+
+	// Loops follow the cycles closed by the bands not in a spanning tree of the circles.
+	parent := make([]seifertStep, len(circles))
+	for i := range parent {
+		parent[i] = seifertStep{-1, -1}
+	}
+	parent[0] = seifertStep{-1, 0}
+	tree := map[int]bool{}
+	for queue := []int{0}; len(queue) > 0; queue = queue[1:] {
+		for i, b := range bands {
+			for _, c := range [][2]int{{b.under, b.over}, {b.over, b.under}} {
+				if c[0] == queue[0] && parent[c[1]].circle < 0 {
+					parent[c[1]] = seifertStep{i, c[0]}
+					tree[i] = true
+					queue = append(queue, c[1])
+				}
+			}
+		}
+	}
+	root := func(c int) []int {
+		ret := []int{c}
+		for ; parent[c].cross >= 0; c = parent[c].circle {
+			ret = append(ret, parent[c].circle)
+		}
+		return ret
+	}
+	loops := [][]seifertStep{}
+	for i, b := range bands {
+		if tree[i] {
+			continue
+		}
+		// Go through the band from the under circle to the over one, then back along the tree.
+		up, down := root(b.over), root(b.under)
+		for len(up) > 1 && len(down) > 1 && up[len(up)-2] == down[len(down)-2] {
+			up, down = up[:len(up)-1], down[:len(down)-1]
+		}
+		loop := []seifertStep{{i, b.over}}
+		for _, c := range up[:len(up)-1] {
+			loop = append(loop, seifertStep{parent[c].cross, parent[c].circle})
+		}
+		for j := len(down) - 2; j >= 0; j-- {
+			loop = append(loop, seifertStep{parent[down[j]].cross, down[j]})
+		}
+		loops = append(loops, loop)
+	}
+	if len(loops) == 0 {
+		return nil
+	}
+
+	n := len(loops)
+	m := poly.NewInt64M(uint(n), uint(n))
+	for row, a := range loops {
+		for col, b := range loops {
+			m.Elements[row*n+col][0].C = d.seifertLink(bands, circles, ccw, a, b)
+		}
+	}
+
+	return m
+}
+
+// seifertOrientation reports for each Seifert circle whether it goes counter-clockwise, i.e. whether the part of the
+// plane on its left is the inside. The outside of the outermost circles is the region around the first face.
+// Regions are bounded by circles. Each is made of faces of the diagram, joined across the bands at the crosses.
+func (d *diagram) seifertOrientation(circles [][]int) []bool {
+	faces := d.faces()
+	face := map[[2]int]int{}
+	for f, ends := range faces {
+		for _, end := range ends {
+			face[end] = f
+		}
+	}
+	region := make([]int, len(faces))
+	for f := range region {
+		region[f] = f
+	}
+	find := func(f int) int {
+		for region[f] != f {
+			f = region[f]
+		}
+		return f
+	}
+	for i, x := range d.x {
+		// The band lies between the faces on the left of the edges going out of the cross, or the ones going in.
+		a, b := [2]int{i, 0}, [2]int{i, 2}
+		if x.h == Right {
+			a, b = [2]int{i, 1}, [2]int{i, 3}
+		}
+		region[find(face[a])] = find(face[b])
+	}
+
+	// Regions on the left and right of each circle, found from the ends of its first edge.
+	left, right := make([]int, len(circles)), make([]int, len(circles))
+	heads := d.heads()
+	for i, x := range d.x {
+		for _, j := range []int{2, x.overOut()} {
+			for c, edges := range circles {
+				if edges[0] == x.e[j] {
+					left[c], right[c] = find(face[[2]int{i, j}]), find(face[heads[x.e[j]]])
+				}
+			}
+		}
+	}
+
+	ret := make([]bool, len(circles))
+	seen := map[int]bool{find(0): true}
+	for queue := []int{find(0)}; len(queue) > 0; queue = queue[1:] {
+		for c := range circles {
+			if r := queue[0]; left[c] == r && !seen[right[c]] {
+				seen[right[c]] = true
+				queue = append(queue, right[c])
+			} else if right[c] == r && !seen[left[c]] {
+				seen[left[c]] = true
+				ret[c] = true
+				queue = append(queue, left[c])
+			}
+		}
+	}
+
+	return ret
+}
+
+// seifertLink returns the linking number of loop 'a' with loop 'b' pushed off the Seifert surface in the positive
+// direction, counting the crosses where 'a' goes over 'b', looking at the diagram from above.
+//
+// On each disk, loops go along its edge from the band they come in through to the one they go out through, in the
+// direction of the circle. The disks of counter-clockwise circles face up. Bands to the circles inside lie above the
+// disk, folding over its edge. Loops keep to a lane, 'a' closer to the edge, and in each band, to a track, 'a' closer
+// to the edge of the band going over at the cross.
+func (d *diagram) seifertLink(bands []band, circles [][]int, ccw []bool, a, b []seifertStep) int64 {
+	// Bands going in to and out of each circle visited by a loop, and the circles each band is crossed to.
+	type visit struct{ in, out int }
+	visits := func(loop []seifertStep) (map[int]visit, map[int]int) {
+		v, to := map[int]visit{}, map[int]int{}
+		for i, s := range loop {
+			v[s.circle] = visit{s.cross, loop[(i+1)%len(loop)].cross}
+			to[s.cross] = s.circle
+		}
+		return v, to
+	}
+	va, ta := visits(a)
+	vb, tb := visits(b)
+
+	ret := int64(0)
+	for c, x := range va {
+		y, ok := vb[c]
+		if !ok {
+			continue
+		}
+		size := 3 * len(circles[c])
+		// Position along the circle where a loop meets the band: the track closer to the edge of the band going over
+		// at the cross is further along the circle through the incoming under-edge.
+		at := func(cross int, first bool) int {
+			p, off := bands[cross].underPos, 1
+			if bands[cross].under != c {
+				p, off = bands[cross].overPos, -1
+			}
+			if !first {
+				off = -off
+			}
+			return 3*p + 1 + off
+		}
+		between := func(p, from, to int) bool {
+			return (p-from+size)%size > 0 && (p-from+size)%size < (to-from+size)%size
+		}
+		inward := func(cross int) bool {
+			// The band is on the left of the circle through the incoming under-edge of a right-handed cross.
+			left := (bands[cross].under == c) == (d.x[cross].h == Right)
+			return left == ccw[c]
+		}
+		up := int64(1)
+		if !ccw[c] {
+			up = -1
+		}
+
+		bIn, bOut := at(y.in, false), at(y.out, false)
+		// Bands to the circles inside pass over the lane of 'b'.
+		if inward(x.in) && between(at(x.in, true), bIn, bOut) {
+			ret += up
+		}
+		if inward(x.out) && between(at(x.out, true), bIn, bOut) {
+			ret -= up
+		}
+		// If the disk faces down, the lane of 'a' passes over 'b' going to and from its lane.
+		if !ccw[c] {
+			aIn, aOut := at(x.in, true), at(x.out, true)
+			if between(bIn, aIn, aOut) {
+				ret--
+			}
+			if between(bOut, aIn, aOut) {
+				ret++
+			}
+		}
+	}
+
+	// In each band crossed by both loops, the track of 'a' goes over that of 'b'.
+	for cross, c := range ta {
+		if e, ok := tb[cross]; ok {
+			sign := int64(-1)
+			if d.x[cross].h == Left {
+				sign = 1
+			}
+			if c != e {
+				sign = -sign
+			}
+			ret += sign
+		}
+	}
+
+	return ret
+}
+
+// SeifertMatrix generates the Seifert matrix of the closure of the braid.
+// The Seifert surface consists of a disk for each strand, stacked on top of each other, joined by a half-twisted band
+// at each generator. The first homology of the surface is generated by loops going from one strand to the next through
+// a band, and coming back through the next band between the same two strands. Rows and columns correspond to these
+// loops, ordered by the lower strand, then by position in the word. Each element is the linking number of the loop of
+// its row with the loop of its column, pushed off the surface in the positive direction.
+// The Alexander polynomial is det(V - tVᵀ), and the signature is that of V + Vᵀ.
+// If the surface is made of disks only, or the braid is not valid, nil is returned.
+func (b Braid) SeifertMatrix() *poly.Int64M {
+	if b.Link() == nil {
+		return nil
+	}
+
+	// Each loop passes through two bands, at positions p < q, between the i-th strand and the next.
+	type loop struct{ i, p, q int }
+	loops := []loop{}
+	for i := 1; i < b.Strands; i++ {
+		p := -1
+		for q, g := range b.Word {
+			if abs(g) != i {
+				continue
+			}
+			if p >= 0 {
+				loops = append(loops, loop{i, p, q})
+			}
+			p = q
+		}
+	}
+	if len(loops) == 0 {
+		return nil
+	}
+
+	sign := func(pos int) int64 {
+		if b.Word[pos] > 0 {
+			return 1
+		}
+		return -1
+	}
+	n := len(loops)
+	m := poly.NewInt64M(uint(n), uint(n))
+	set := func(row, col int, c int64) {
+		m.Elements[row*n+col][0].C = c
+	}
+	for row, x := range loops {
+		// Each band contributes half a twist.
+		set(row, row, -(sign(x.p)+sign(x.q))/2)
+		for col, y := range loops {
+			switch {
+			case y.i == x.i && y.p == x.q:
+				// Consecutive loops between the same strands share a band.
+				if sign(x.q) > 0 {
+					set(row, col, 1)
+				} else {
+					set(col, row, -1)
+				}
+			case y.i == x.i+1 && x.p < y.p && y.p < x.q && x.q < y.q:
+				// Loops on adjacent strands cross on the shared disk if their bands interleave.
+				set(row, col, 1)
+			case y.i == x.i+1 && y.p < x.p && x.p < y.q && y.q < x.q:
+				set(row, col, -1)
+			}
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,142 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+	"github.com/attilaolah/math/go/poly"
+)
+
+func TestSeifertCircles(t *testing.T) {
+	for i, row := range []struct {
+		k              *knot.Knot
+		circles, genus int
+	}{
+		{knot.Unknot(), 1, 0},
+		{mustParseGaussCode("O1- U1-"), 2, 0},
+		{knot.Trefoil(), 2, 1},
+		{knot.FigureEight(), 3, 1},
+		{knot.SimpleKnot(5), 2, -1},
+		{knot.Braid{2, []int{1, 1, 1, 1, 1}}.Closure(), 2, 2},
+		{knot.Braid{3, []int{1, 2, 1, 2, 1, 2, 1, 2}}.Closure(), 3, 3},
+		{knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure(), 4, 2},
+	} {
+		if got := row.k.SeifertCircles(); got != row.circles {
+			t.Errorf("#%d: SeifertCircles() = %d; want: %d", i+1, got, row.circles)
+		}
+		if got := row.k.SeifertGenus(); got != row.genus {
+			t.Errorf("#%d: SeifertGenus() = %d; want: %d", i+1, got, row.genus)
+		}
+	}
+}
+
+func TestSeifertMatrix(t *testing.T) {
+	if m := knot.Unknot().SeifertMatrix(); m != nil {
+		t.Errorf("Unknot().SeifertMatrix() = %v; want: nil", m)
+	}
+
+	want := `
+⎡-1  1⎤
+⎣ 0 -1⎦`[1:]
+	if got := (knot.Braid{2, []int{1, 1, 1}}).SeifertMatrix().String(); got != want {
+		t.Errorf("SeifertMatrix() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSeifertMatrixSize(t *testing.T) {
+	for i, row := range []struct {
+		code []int
+		size int
+	}{
+		{[]int{4, 6, 2}, 2},
+		{[]int{4, 6, 8, 2}, 2},
+		// 5₂ is not a braid closure: Braid().SeifertMatrix() is 6×6.
+		{[]int{4, 8, 10, 2, 6}, 2},
+		{[]int{6, 8, 10, 2, 4}, 4},
+		{[]int{4, 8, -12, 2, -14, -16, -6, -10}, 6},
+	} {
+		k, err := knot.FromDTCode(row.code)
+		if err != nil {
+			t.Errorf("#%d: FromDTCode(%v) returned error: %v", i+1, row.code, err)
+			continue
+		}
+		if got, want := int(k.SeifertMatrix().Stride), 2*k.SeifertGenus(); got != want || got != row.size {
+			t.Errorf("#%d: FromDTCode(%v).SeifertMatrix() is %d×%d; want: %d×%d", i+1, row.code, got, got, want, want)
+		}
+	}
+}
+
+func TestSeifertMatrixInvariants(t *testing.T) {
+	fiveTwo, err := knot.FromDTCode([]int{4, 8, 10, 2, 6})
+	if err != nil {
+		t.Fatalf("FromDTCode() returned error: %v", err)
+	}
+
+	for i, k := range []*knot.Knot{
+		knot.Trefoil(),
+		knot.FigureEight(),
+		fiveTwo,
+		knot.Braid{2, []int{1, 1, 1, 1, 1}}.Closure(),
+		knot.Braid{3, []int{1, 2, 1, 2, 1, 2, 1, 2}}.Closure(),
+		knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure(),
+		knot.Braid{3, []int{1, -2, 1, -2, 1, -2, 1, -2}}.Closure(),
+	} {
+		v := k.SeifertMatrix()
+		n := int(v.Stride)
+		// V - tVᵀ, V + Vᵀ and V - Vᵀ.
+		a, s, u := poly.NewInt64M(uint(n), uint(n)), poly.NewInt64M(uint(n), uint(n)), poly.NewInt64M(uint(n), uint(n))
+		for row := 0; row < n; row++ {
+			for col := 0; col < n; col++ {
+				x, y := v.Elements[row*n+col][0].C, v.Elements[col*n+row][0].C
+				a.Elements[row*n+col] = poly.Int64P{{C: x, Ind: []int64{0}}, {C: -y, Ind: []int64{1}}}.Compact()
+				s.Elements[row*n+col][0].C = x + y
+				u.Elements[row*n+col][0].C = x - y
+			}
+		}
+
+		if det := u.Det(); len(det) != 1 || det[0].C != 1 {
+			t.Errorf("#%d: det(V - Vᵀ) = %s; want: 1", i+1, det)
+		}
+		if got, want := a.Det(), k.Alexander(); !associate(got, want) {
+			t.Errorf("#%d: det(V - tVᵀ) = %s; want: ±tᵏ(%s)", i+1, got, want)
+		}
+		if got, err := s.Signature(); err != nil || got != k.Signature() {
+			t.Errorf("#%d: signature of V + Vᵀ = %d, %v; want: %d", i+1, got, err, k.Signature())
+		}
+	}
+}
+
+// associate reports whether p = ±tᵏq for some k.
+func associate(p, q poly.Int64P) bool {
+	nonZero := func(p poly.Int64P) poly.Int64P {
+		ret := poly.Int64P{}
+		for _, t := range p.Compact() {
+			if t.C != 0 {
+				ret = append(ret, t)
+			}
+		}
+		return ret
+	}
+	exp := func(t poly.Int64T) int64 {
+		if len(t.Ind) == 0 {
+			return 0
+		}
+		return t.Ind[0]
+	}
+
+	p, q = nonZero(p), nonZero(q)
+	if len(p) != len(q) || len(p) == 0 {
+		return len(p) == len(q)
+	}
+	sign, shift := p[0].C/q[0].C, exp(p[0])-exp(q[0])
+	if sign != 1 && sign != -1 {
+		return false
+	}
+	for i := range p {
+		if p[i].C != sign*q[i].C || exp(p[i])-exp(q[i]) != shift {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,28 @@
+package knot
+
+// Signature calculates the signature of the knot.
+// It uses the Gordon–Litherland formula σ = sign(G) - μ, where G is the Goeritz matrix of the diagram, and μ is a
+// correction term summed over the crosses where the checkerboard surface does not follow the orientation of the knot.
+// Right-handed knots have negative signature, e.g. -2 for the right-handed trefoil.
+// If the diagram is not planar, 0 is returned.
+func (k *Knot) Signature() int {
+	d := k.diagram()
+	if len(d.x) == 0 {
+		return 0
+	}
+	faces := d.faces()
+	if len(faces) != len(d.x)+2 {
+		return 0
+	}
+
+	g, mu := d.goeritz(faces, d.checkerboard(faces))
+	if g == nil {
+		return -mu
+	}
+	s, err := g.Signature()
+	if err != nil {
+		panic(err)
+	}
+
+	return s - mu
+}
@@ -0,0 +1,56 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestSignature(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[1])
+
+	for i, row := range []struct {
+		k    *knot.Knot
+		want int
+	}{
+		{knot.Unknot(), 0},
+		{knot.Trefoil(), 2},
+		{twisted, 2},
+		{knot.Braid{2, []int{1, 1, 1}}.Closure(), -2},
+		{knot.Braid{2, []int{1, 1, 1, 1, 1}}.Closure(), -4},
+		{knot.Braid{2, []int{-1, -1, -1, -1, -1}}.Closure(), 4},
+		{knot.Braid{3, []int{1, -2, 1, -2}}.Closure(), 0},
+		{knot.Braid{3, []int{1, 2, 1, 2, 1, 2, 1, 2}}.Closure(), -6},
+		{knot.Braid{3, []int{1, 1, 1, 2, 2, 2}}.Closure(), -4},
+		{knot.Braid{3, []int{1, 1, 1, -2, -2, -2}}.Closure(), 0},
+		{knot.Braid{4, []int{1, 1, 2, -1, -3, 2, -3}}.Closure(), 0},
+		{knot.Braid{3, []int{1, -2, 1, -2, 1, -2, 1, -2}}.Closure(), 0},
+	} {
+		if got := row.k.Signature(); got != row.want {
+			t.Errorf("#%d: Signature() = %d; want: %d", i+1, got, row.want)
+		}
+	}
+}
+
+func TestSignatureMirror(t *testing.T) {
+	for i, braid := range []knot.Braid{
+		{2, []int{1, 1, 1}},
+		{3, []int{1, 1, 1, 2, -1, 2}},
+		{3, []int{1, 2, 1, 2, 1, 2, 1, 2}},
+		{4, []int{1, 1, 1, 2, -1, 2, 3, -2, 3}},
+	} {
+		mirror := knot.Braid{braid.Strands, make([]int, len(braid.Word))}
+		for j, g := range braid.Word {
+			mirror.Word[j] = -g
+		}
+		a, b := braid.Closure(), mirror.Closure()
+		if a == nil || b == nil {
+			t.Fatalf("#%d: %v does not close to a knot", i+1, braid)
+		}
+		if sa, sb := a.Signature(), b.Signature(); sa != -sb {
+			t.Errorf("#%d: Signature() = %d; mirror: %d", i+1, sa, sb)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package knot
+
+// SimplifyOptions limits the search for third Reidemeister moves in Simplify().
+type SimplifyOptions struct {
+	// Depth is the maximum number of moves in a row, tried when no cross can be removed directly.
+	Depth int
+	// Budget is the maximum number of moves tried in total.
+	Budget int
+}
+
+// Simplify reduces the number of crosses in the diagram, using Reidemeister moves.
+// Crosses are removed by the first and second moves, listed by Reductions(), as long as possible. Then sequences of
+// third moves are searched, up to the depth in the options, until one of them allows removing more crosses. Moves of a
+// sequence that does not lead to a reduction are undone.
+// The knot is simplified in place, and returned for convenience, together with the moves applied to it. The moves are
+// also recorded in its History(), so they can be undone using Undo().
+func Simplify(k *Knot, opts SimplifyOptions) (*Knot, Moves) {
+	s := simplifier{k: k, budget: opts.Budget}
+	n := len(k.history)
+	// Each search that succeeds is followed by a reduction, so this stops when the search fails or runs out of budget.
+	for s.reduce() || s.search(opts.Depth, nil, nil) {
+	}
+
+	return k, k.History()[n:]
+}
+
+// simplifier keeps track of the number of third moves that can still be tried on the knot.
+type simplifier struct {
+	k      *Knot
+	budget int
+}
+
+// reduce applies the first reduction listed for the knot, and reports whether there was one.
+func (s *simplifier) reduce() bool {
+	rs := s.k.Reductions()
+	if len(rs) == 0 {
+		return false
+	}
+
+	r := rs[0]
+	m := Move{Kind: UntwistMove, Crosses: s.crosses(r.Crosses...)}
+	if r.Move == 2 {
+		m.Kind = UnpokeMove
+	}
+	if s.k.apply(m) != nil {
+		panic("knot: should not happen")
+	}
+
+	return true
+}
+
+// search tries sequences of third moves, up to the given depth, until one of them allows a reduction.
+// Moves are kept if it is found, otherwise they are all undone. The previous move of the sequence, sliding 'prev'
+// over 'over', is not repeated, as that would undo it.
+func (s *simplifier) search(depth int, prev *Arc, over *Cross) bool {
+	if depth <= 0 {
+		return false
+	}
+
+	for i, a := range s.k.Arcs() {
+		if len(a.Over) != 0 {
+			continue
+		}
+		for j, c := range s.k.Crosses() {
+			if s.budget <= 0 {
+				return false
+			}
+			if a == prev && c == over {
+				continue
+			}
+			if s.k.apply(Move{Kind: SlideMove, Arcs: []int{i}, Crosses: []int{j}}) != nil {
+				continue
+			}
+			s.budget--
+			if len(s.k.Reductions()) > 0 || s.search(depth-1, a, c) {
+				return true
+			}
+			s.k.Undo()
+		}
+	}
+
+	return false
+}
+
+// crosses returns the positions of the crosses in Crosses().
+func (s *simplifier) crosses(crosses ...*Cross) []int {
+	ret := []int{}
+	for _, c := range crosses {
+		for i, x := range s.k.Crosses() {
+			if x == c {
+				ret = append(ret, i)
+			}
+		}
+	}
+
+	return ret
+}
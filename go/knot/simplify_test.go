@@ -0,0 +1,76 @@
+package knot_test
+
+import (
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestSimplify(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[3])
+	poked := knot.Trefoil()
+	for _, left := range []bool{true, false, true} {
+		arcs := poked.Arcs()
+		if _, _, err := knot.PokeFrom(arcs[len(arcs)-1], arcs[0], left); err != nil {
+			t.Fatalf("PokeFrom() returned error: %v", err)
+		}
+	}
+	unknot := knot.Unknot()
+	knot.TwistLeft(unknot.Arcs()[0])
+	knot.TwistRight(unknot.Arcs()[0])
+	knot.TwistRight(unknot.Arcs()[1])
+	// A trefoil with four crosses, which cannot be reduced without sliding.
+	stuck, err := knot.ParseGaussCode("O1- O2- U3- U1- O4- O3- U2- U4-")
+	if err != nil {
+		t.Fatalf("ParseGaussCode() returned error: %v", err)
+	}
+
+	for i, row := range []struct {
+		k      *knot.Knot
+		opts   knot.SimplifyOptions
+		size   int
+		slides bool
+	}{
+		{knot.Unknot(), knot.SimplifyOptions{}, 0, false},
+		{knot.Trefoil(), knot.SimplifyOptions{Depth: 2, Budget: 100}, 3, false},
+		{twisted, knot.SimplifyOptions{}, 3, false},
+		{poked, knot.SimplifyOptions{}, 3, false},
+		{unknot, knot.SimplifyOptions{}, 0, false},
+		{stuck, knot.SimplifyOptions{}, 4, false},
+		{stuck, knot.SimplifyOptions{Depth: 2, Budget: 100}, 3, true},
+	} {
+		jones := row.k.Jones().String()
+		size := row.k.Size()
+		k, moves := knot.Simplify(row.k, row.opts)
+		if k != row.k {
+			t.Errorf("#%d: Simplify() returned a different knot", i+1)
+		}
+		if got := k.Size(); got != row.size {
+			t.Errorf("#%d: after Simplify(): k.Size() = %d; want: %d", i+1, got, row.size)
+		}
+		if got := k.Jones().String(); got != jones {
+			t.Errorf("#%d: after Simplify(): k.Jones() = %q; want: %q", i+1, got, jones)
+		}
+		if _, err := k.Faces(); err != nil {
+			t.Errorf("#%d: after Simplify(): k.Faces() returned error: %v", i+1, err)
+		}
+
+		removed, slides := 0, false
+		for _, m := range moves {
+			switch m.Kind {
+			case knot.UntwistMove:
+				removed++
+			case knot.UnpokeMove:
+				removed += 2
+			case knot.SlideMove:
+				slides = true
+			}
+		}
+		if removed != size-row.size || slides != row.slides {
+			t.Errorf("#%d: Simplify() moves remove %d crosses, slides: %v; want: %d, %v", i+1, removed, slides, size-row.size, row.slides)
+		}
+	}
+}
@@ -1,19 +1,31 @@
 package knot
 
 func Unknot() *Knot {
-	return &Knot{&Arc{}}
+	return &Knot{start: &Arc{}}
 }
 
 func Trefoil() *Knot {
 	return SimpleKnot(3)
 }
 
+// RightTrefoil creates the right-handed trefoil, the mirror image of Trefoil().
+func RightTrefoil() *Knot {
+	k := Trefoil()
+	for _, c := range k.Crosses() {
+		c.Handedness = Right
+	}
+
+	return k
+}
+
 func FigureEight() *Knot {
 	return SimpleKnot(4)
 }
 
 // SimpleKnot creates a simple Knot.
 // Each Arc crosses over the start of its previous Arc in linkage.
+// For an even size, the handedness of the crosses alternates, otherwise all crosses are left-handed. This makes the
+// diagram planar for sizes up to 4, i.e. for the trefoil and the figure-eight knot. Larger sizes are never planar.
 func SimpleKnot(size int) *Knot {
 	arcs := make([]*Arc, size)
 	crosses := make([]*Cross, size)
@@ -28,7 +40,19 @@ func SimpleKnot(size int) *Knot {
 		a.Stop = crosses[(i+1)%size]
 		a.Stop.In = a
 		crosses[(size+i-1)%size].Over = a
+		a.Over = []*Cross{crosses[(size+i-1)%size]}
+		crosses[i].Handedness = Handedness(size%2 == 0 && i%2 == 1)
 	}
 
-	return &Knot{arcs[0]}
+	return &Knot{start: arcs[0]}
+}
+
+// HopfLink creates the Hopf link, two unknots linked once, with right-handed crosses.
+func HopfLink() *Link {
+	return Braid{2, []int{1, 1}}.Link()
+}
+
+// WhiteheadLink creates the Whitehead link, whose components have a linking number of 0.
+func WhiteheadLink() *Link {
+	return Braid{3, []int{1, 1, -2, 1, -2}}.Link()
 }
@@ -0,0 +1,95 @@
+package knot
+
+// CrossStats summarizes the crosses in a diagram.
+type CrossStats struct {
+	// Number of left- and right-handed crosses.
+	Left, Right int
+	// Number of crosses between different components of a link.
+	Mixed int
+	// Number of left- and right-handed twists, i.e. crosses where an arc crosses itself without crossing anything else
+	// in between. These can be undone by the first Reidemeister move.
+	LeftTwists, RightTwists int
+}
+
+// Writhe returns the sum of signs of the crosses, right-handed crosses counting as +1.
+func (k *Knot) Writhe() int {
+	return k.Link().Writhe()
+}
+
+// Writhe returns the sum of signs of the crosses, right-handed crosses counting as +1.
+func (l *Link) Writhe() int {
+	s := l.CrossStats()
+	return s.Right - s.Left
+}
+
+// CrossStats counts the crosses in the knot, see CrossStats.
+func (k *Knot) CrossStats() CrossStats {
+	return k.Link().CrossStats()
+}
+
+// CrossStats counts the crosses in the link, see CrossStats.
+func (l *Link) CrossStats() CrossStats {
+	component := l.component()
+	ret := CrossStats{}
+	for _, c := range l.Crosses() {
+		if c.Handedness == Right {
+			ret.Right++
+		} else {
+			ret.Left++
+		}
+		if component[c.In] != component[c.Over] {
+			ret.Mixed++
+		}
+		if !isTwist(c) {
+			continue
+		}
+		if c.Handedness == Right {
+			ret.RightTwists++
+		} else {
+			ret.LeftTwists++
+		}
+	}
+
+	return ret
+}
+
+// LinkingNumbers returns the linking numbers between each pair of components, as a symmetric matrix.
+// The diagonal contains the writhe of each component on its own, i.e. the sum of signs of the crosses where it crosses
+// itself, which is the linking number of the component with a copy of itself pushed off along the diagram.
+func (l *Link) LinkingNumbers() [][]int {
+	component := l.component()
+	ret := make([][]int, len(l.start))
+	for i := range ret {
+		ret[i] = make([]int, len(l.start))
+	}
+	for _, c := range l.Crosses() {
+		i, j := component[c.In], component[c.Over]
+		s := -1
+		if c.Handedness == Right {
+			s = 1
+		}
+		if i == j {
+			ret[i][i] += 2 * s
+		} else {
+			ret[i][j] += s
+			ret[j][i] += s
+		}
+	}
+	for i := range ret {
+		for j := range ret[i] {
+			ret[i][j] /= 2
+		}
+	}
+
+	return ret
+}
+
+// isTwist reports whether the cross can be undone by the first Reidemeister move, i.e. whether the arc going out of
+// the cross goes over it first, or the arc coming in went over it last.
+func isTwist(c *Cross) bool {
+	if c.Over == c.Out && len(c.Out.Over) > 0 && c.Out.Over[0] == c {
+		return true
+	}
+
+	return c.Over == c.In && len(c.In.Over) > 0 && c.In.Over[len(c.In.Over)-1] == c
+}
@@ -0,0 +1,71 @@
+package knot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/attilaolah/math/go/knot"
+)
+
+func TestWrithe(t *testing.T) {
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistRight(twisted.Arcs()[1])
+
+	for i, row := range []struct {
+		k    *knot.Knot
+		want int
+	}{
+		{knot.Unknot(), 0},
+		{knot.Trefoil(), -3},
+		{knot.Braid{2, []int{1, 1, 1}}.Closure(), 3},
+		{knot.Braid{3, []int{1, -2, 1, -2}}.Closure(), 0},
+		{twisted, -1},
+	} {
+		if got := row.k.Writhe(); got != row.want {
+			t.Errorf("#%d: Writhe() = %d; want: %d", i+1, got, row.want)
+		}
+	}
+}
+
+func TestCrossStats(t *testing.T) {
+	unknot := knot.Unknot()
+	knot.TwistLeft(unknot.Arcs()[0])
+	twisted := knot.Trefoil()
+	knot.TwistRight(twisted.Arcs()[0])
+	knot.TwistLeft(twisted.Arcs()[1])
+	knot.TwistLeft(twisted.Arcs()[2])
+
+	for i, row := range []struct {
+		l    *knot.Link
+		want knot.CrossStats
+	}{
+		{knot.Unknot().Link(), knot.CrossStats{}},
+		{unknot.Link(), knot.CrossStats{Left: 1, LeftTwists: 1}},
+		{knot.Trefoil().Link(), knot.CrossStats{Left: 3}},
+		{twisted.Link(), knot.CrossStats{Left: 5, Right: 1, LeftTwists: 2, RightTwists: 1}},
+		{knot.HopfLink(), knot.CrossStats{Right: 2, Mixed: 2}},
+		{knot.WhiteheadLink(), knot.CrossStats{Left: 2, Right: 3, Mixed: 4}},
+	} {
+		if got := row.l.CrossStats(); got != row.want {
+			t.Errorf("#%d: CrossStats() = %+v; want: %+v", i+1, got, row.want)
+		}
+	}
+}
+
+func TestLinkingNumbers(t *testing.T) {
+	for i, row := range []struct {
+		l    *knot.Link
+		want [][]int
+	}{
+		{knot.Trefoil().Link(), [][]int{{-3}}},
+		{knot.HopfLink(), [][]int{{0, 1}, {1, 0}}},
+		{knot.WhiteheadLink(), [][]int{{1, 0}, {0, 0}}},
+		{knot.Braid{3, []int{1, 1, -2, -2}}.Link(), [][]int{{0, 1, 0}, {1, 0, -1}, {0, -1, 0}}},
+		{knot.NewLink(knot.Trefoil(), knot.Braid{2, []int{1, 1, 1}}.Closure()), [][]int{{-3, 0}, {0, 3}}},
+	} {
+		if got := row.l.LinkingNumbers(); !reflect.DeepEqual(got, row.want) {
+			t.Errorf("#%d: LinkingNumbers() = %v; want: %v", i+1, got, row.want)
+		}
+	}
+}
@@ -16,6 +16,7 @@ package poly
 
 import (
 	"fmt"
+	"math/big"
 	"math/rand"
 	"strings"
 )
@@ -42,6 +43,7 @@ func NewInt64M(rows, cols uint) *Int64M {
 }
 
 // Det calculates the determinant of the square matrix.
+// It uses Bareiss' fraction-free elimination, which only needs a polynomial number of exact divisions.
 func (m Int64M) Det() Int64P {
 	if m.Stride*m.Stride != uint(len(m.Elements)) {
 		panic("math error: determinant of non-square matrix")
@@ -54,11 +56,55 @@ func (m Int64M) Det() Int64P {
 		return m.Elements[0]
 	}
 
-	ret, sign := Int64P{}, int64(1)
-	for i := uint(0); i < m.Stride; i++ {
-		p := m.Elements[i].MulT(Int64T{Ind{}, sign})
-		ret = ret.Add(p.Mul(m.Minor(0, i).Det()))
-		sign *= -1
+	// Pad all indeterminates to the same length, so that terms can be compared.
+	size := 0
+	for _, p := range m.Elements {
+		for _, t := range p {
+			if len(t.Ind) > size {
+				size = len(t.Ind)
+			}
+		}
+	}
+	n := int(m.Stride)
+	a := make([][]Int64P, n)
+	for i := range a {
+		a[i] = make([]Int64P, n)
+		for j := range a[i] {
+			a[i][j] = m.Elements[i*n+j].pad(size).trim()
+		}
+	}
+
+	zero := Int64P{Int64T{Ind: make(Ind, size)}}
+	prev, sign := Int64P{Int64T{make(Ind, size), 1}}, int64(1)
+	for k := 0; k < n-1; k++ {
+		if len(a[k][k]) == 0 {
+			// Swap in a row with a non-zero pivot.
+			i := k + 1
+			for i < n && len(a[i][k]) == 0 {
+				i++
+			}
+			if i == n {
+				return zero
+			}
+			a[k], a[i] = a[i], a[k]
+			sign *= -1
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				p := a[k][k].Mul(a[i][j]).Add(a[i][k].Mul(a[k][j]).MulT(Int64T{make(Ind, size), -1}))
+				q, err := p.Div(prev)
+				if err != nil {
+					panic(err)
+				}
+				a[i][j] = q
+			}
+		}
+		prev = a[k][k]
+	}
+
+	ret := a[n-1][n-1].MulT(Int64T{make(Ind, size), sign})
+	if len(ret) == 0 {
+		return zero
 	}
 	return ret
 }
@@ -133,3 +179,232 @@ func (m Int64M) String() string {
 
 	return strings.Join(ret, "\n")
 }
+
+// SmithNormalForm calculates the Smith normal form of a matrix with constant elements.
+// It returns the diagonal 'd', with non-negative elements each dividing the next, and unimodular matrices 'u' and 'v',
+// so that u·m·v has 'd' along its diagonal and zeros elsewhere. The non-zero elements of 'd' are the invariant factors
+// of the matrix.
+// If the matrix has non-constant elements, NonConstantError is returned.
+func (m Int64M) SmithNormalForm() (d []int64, u, v *Int64M, err error) {
+	cols := int(m.Stride)
+	rows := 0
+	if cols > 0 {
+		rows = len(m.Elements) / cols
+	}
+	a := make([][]int64, rows)
+	for i := range a {
+		a[i] = make([]int64, cols)
+		for j := range a[i] {
+			c, ok := m.Elements[i*cols+j].constant()
+			if !ok {
+				return nil, nil, nil, NonConstantError
+			}
+			a[i][j] = c
+		}
+	}
+	// Row operations are also applied to 'l', and column operations to 'r'.
+	l, r := identity(rows), identity(cols)
+	swapRows := func(i, j int) {
+		a[i], a[j] = a[j], a[i]
+		l[i], l[j] = l[j], l[i]
+	}
+	addRow := func(i, j int, f int64) {
+		for k := range a[i] {
+			a[i][k] += f * a[j][k]
+		}
+		for k := range l[i] {
+			l[i][k] += f * l[j][k]
+		}
+	}
+	swapCols := func(i, j int) {
+		for _, row := range a {
+			row[i], row[j] = row[j], row[i]
+		}
+		for _, row := range r {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+	addCol := func(i, j int, f int64) {
+		for _, row := range a {
+			row[i] += f * row[j]
+		}
+		for _, row := range r {
+			row[i] += f * row[j]
+		}
+	}
+
+	d = make([]int64, min(rows, cols))
+	for t := range d {
+		for done := false; !done; {
+			// Move the smallest non-zero element to the pivot.
+			pi, pj := -1, -1
+			for i := t; i < rows; i++ {
+				for j := t; j < cols; j++ {
+					if a[i][j] != 0 && (pi < 0 || abs(a[i][j]) < abs(a[pi][pj])) {
+						pi, pj = i, j
+					}
+				}
+			}
+			if pi < 0 {
+				break
+			}
+			swapRows(t, pi)
+			swapCols(t, pj)
+
+			// Reduce the rest of the row and column. Remainders are smaller than the pivot, and are moved to the
+			// pivot in the next round.
+			done = true
+			for i := t + 1; i < rows; i++ {
+				addRow(i, t, -a[i][t]/a[t][t])
+				done = done && a[i][t] == 0
+			}
+			for j := t + 1; j < cols; j++ {
+				addCol(j, t, -a[t][j]/a[t][t])
+				done = done && a[t][j] == 0
+			}
+			if !done {
+				continue
+			}
+
+			// The pivot must divide all the remaining elements. If not, adding the row makes a remainder.
+			for i := t + 1; i < rows && done; i++ {
+				for j := t + 1; j < cols; j++ {
+					if a[i][j]%a[t][t] != 0 {
+						addRow(t, i, 1)
+						done = false
+						break
+					}
+				}
+			}
+		}
+		if a[t][t] < 0 {
+			addRow(t, t, -2)
+		}
+		d[t] = a[t][t]
+	}
+
+	return d, newInt64M(l), newInt64M(r), nil
+}
+
+// identity returns the n×n identity matrix.
+func identity(n int) [][]int64 {
+	ret := make([][]int64, n)
+	for i := range ret {
+		ret[i] = make([]int64, n)
+		ret[i][i] = 1
+	}
+	return ret
+}
+
+// newInt64M creates a matrix with constant elements.
+func newInt64M(a [][]int64) *Int64M {
+	cols := 0
+	if len(a) > 0 {
+		cols = len(a[0])
+	}
+	m := NewInt64M(uint(len(a)), uint(cols))
+	for i, row := range a {
+		for j, c := range row {
+			m.Elements[i*cols+j][0].C = c
+		}
+	}
+	return m
+}
+
+func abs(a int64) int64 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Diagonalize calculates a diagonal matrix congruent to the symmetric matrix with constant elements, i.e. one that
+// equals pᵀ·m·p for an invertible rational matrix 'p', and returns its diagonal.
+// It uses symmetric Gaussian elimination: each row operation is followed by the same column operation.
+// If the matrix has non-constant elements, NonConstantError is returned, and NonSymmetricError if it is not
+// symmetric.
+func (m Int64M) Diagonalize() ([]*big.Rat, error) {
+	n := int(m.Stride)
+	if n*n != len(m.Elements) {
+		return nil, NonSymmetricError
+	}
+	a := make([][]*big.Rat, n)
+	for i := range a {
+		a[i] = make([]*big.Rat, n)
+		for j := range a[i] {
+			c, ok := m.Elements[i*n+j].constant()
+			if !ok {
+				return nil, NonConstantError
+			}
+			a[i][j] = big.NewRat(c, 1)
+		}
+	}
+	for i := range a {
+		for j := range a[i] {
+			if a[i][j].Cmp(a[j][i]) != 0 {
+				return nil, NonSymmetricError
+			}
+		}
+	}
+
+	// addRowCol adds 'f' times the j-th row and column to the i-th ones.
+	addRowCol := func(i, j int, f *big.Rat) {
+		for k := range a {
+			a[i][k] = new(big.Rat).Add(a[i][k], new(big.Rat).Mul(f, a[j][k]))
+		}
+		for k := range a {
+			a[k][i] = new(big.Rat).Add(a[k][i], new(big.Rat).Mul(f, a[k][j]))
+		}
+	}
+	one := big.NewRat(1, 1)
+	for k := range a {
+		if a[k][k].Sign() == 0 {
+			// Bring a non-zero element to the diagonal: either swap in a non-zero diagonal element, or add a row
+			// (and column) with a non-zero element in this row, which leaves twice that element on the diagonal.
+			for j := k + 1; j < n && a[k][k].Sign() == 0; j++ {
+				if a[j][j].Sign() != 0 {
+					a[k], a[j] = a[j], a[k]
+					for _, row := range a {
+						row[k], row[j] = row[j], row[k]
+					}
+				}
+			}
+			for j := k + 1; j < n && a[k][k].Sign() == 0; j++ {
+				if a[k][j].Sign() != 0 {
+					addRowCol(k, j, one)
+				}
+			}
+			if a[k][k].Sign() == 0 {
+				continue
+			}
+		}
+		for i := k + 1; i < n; i++ {
+			if a[i][k].Sign() != 0 {
+				addRowCol(i, k, new(big.Rat).Neg(new(big.Rat).Quo(a[i][k], a[k][k])))
+			}
+		}
+	}
+
+	ret := make([]*big.Rat, n)
+	for i := range ret {
+		ret[i] = a[i][i]
+	}
+
+	return ret, nil
+}
+
+// Signature calculates the signature of the symmetric matrix with constant elements, i.e. the number of positive
+// eigenvalues minus the number of negative ones. See Diagonalize() for the errors returned.
+func (m Int64M) Signature() (int, error) {
+	d, err := m.Diagonalize()
+	if err != nil {
+		return 0, err
+	}
+
+	ret := 0
+	for _, r := range d {
+		ret += r.Sign()
+	}
+
+	return ret, nil
+}
@@ -15,6 +15,8 @@
 package poly_test
 
 import (
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -65,6 +67,27 @@ func TestInt64MDet(t *testing.T) {
 				{poly.Int64T{poly.Ind{}, 9}},
 			}, 3,
 		}, "0"},
+		{poly.Int64M{
+			[]poly.Int64P{
+				{poly.Int64T{poly.Ind{}, 0}},
+				{poly.Int64T{poly.Ind{}, 1}},
+				{poly.Int64T{poly.Ind{}, 1}},
+				{poly.Int64T{poly.Ind{}, 0}},
+			}, 2,
+		}, "-1"},
+		{poly.Int64M{
+			[]poly.Int64P{
+				{poly.Int64T{poly.Ind{1}, 1}, poly.Int64T{poly.Ind{0}, -1}},
+				{poly.Int64T{poly.Ind{1}, 1}},
+				{poly.Int64T{poly.Ind{0}, 0}},
+				{poly.Int64T{poly.Ind{0}, 1}},
+				{poly.Int64T{poly.Ind{1}, 1}, poly.Int64T{poly.Ind{0}, -1}},
+				{poly.Int64T{poly.Ind{1}, 1}},
+				{poly.Int64T{poly.Ind{1}, 1}},
+				{poly.Int64T{poly.Ind{0}, 1}},
+				{poly.Int64T{poly.Ind{1}, 1}, poly.Int64T{poly.Ind{0}, -1}},
+			}, 3,
+		}, "2x³ - 5x² + 5x - 1"},
 	} {
 		if got, want := row.m.Det().String(), row.s; got != want {
 			t.Errorf("(\n%s\n).Det() = %q; want: %q", row.m, got, want)
@@ -72,6 +95,150 @@ func TestInt64MDet(t *testing.T) {
 	}
 }
 
+func TestInt64MDetLarge(t *testing.T) {
+	// Tridiagonal matrices with 2 on the diagonal and -1 next to it have determinant n+1.
+	for _, n := range []uint{10, 50, 100} {
+		m := poly.NewInt64M(n, n)
+		for i := uint(0); i < n; i++ {
+			m.Elements[i*n+i] = poly.Int64P{poly.Int64T{poly.Ind{}, 2}}
+			if i > 0 {
+				m.Elements[i*n+i-1] = poly.Int64P{poly.Int64T{poly.Ind{}, -1}}
+				m.Elements[(i-1)*n+i] = poly.Int64P{poly.Int64T{poly.Ind{}, -1}}
+			}
+		}
+		if got, want := m.Det().String(), fmt.Sprint(n+1); got != want {
+			t.Errorf("#%d: Det() = %q; want: %q", n, got, want)
+		}
+	}
+}
+
+func TestInt64MSmithNormalForm(t *testing.T) {
+	for _, row := range []struct {
+		m poly.Int64M
+		d []int64
+	}{
+		{constM(1, 5), []int64{5}},
+		{constM(2, 2, 0, 0, 3), []int64{1, 6}},
+		{constM(2, 0, 4, -6, 0), []int64{2, 12}},
+		{constM(3, 1, 2, 3, 4, 5, 6, 7, 8, 9), []int64{1, 3, 0}},
+		{constM(3, 2, 4, 4, -6, 6, 12, 10, -4, -16), []int64{2, 6, 12}},
+		{constM(2, 1, 2, 3, 4, 5, 6), []int64{1, 2}},
+		{constM(3, 0, 0, 0, 0, 0, 0), []int64{0, 0}},
+	} {
+		d, u, v, err := row.m.SmithNormalForm()
+		if err != nil {
+			t.Errorf("(\n%s\n).SmithNormalForm() returned error: %v", row.m, err)
+			continue
+		}
+		if !reflect.DeepEqual(d, row.d) {
+			t.Errorf("(\n%s\n).SmithNormalForm() d = %v; want: %v", row.m, d, row.d)
+		}
+		for _, x := range []*poly.Int64M{u, v} {
+			if got := x.Det().String(); got != "1" && got != "-1" {
+				t.Errorf("(\n%s\n).SmithNormalForm() returned\n%s\nwith determinant %s; want: ±1", row.m, x, got)
+			}
+		}
+		got := mul(mul(*u, row.m), *v)
+		for i, p := range got.Elements {
+			want := int64(0)
+			if r, c := i/int(got.Stride), i%int(got.Stride); r == c {
+				want = d[r]
+			}
+			if s := p.String(); s != fmt.Sprint(want) {
+				t.Errorf("(\n%s\n).SmithNormalForm(): u·m·v =\n%s", row.m, got)
+				break
+			}
+		}
+	}
+
+	m := poly.Int64M{[]poly.Int64P{{poly.Int64T{poly.Ind{1}, 1}}}, 1}
+	if _, _, _, err := m.SmithNormalForm(); err != poly.NonConstantError {
+		t.Errorf("(%s).SmithNormalForm() error = %v; want: %v", m, err, poly.NonConstantError)
+	}
+}
+
+func TestInt64MDiagonalize(t *testing.T) {
+	for _, row := range []struct {
+		m poly.Int64M
+		d string
+	}{
+		{constM(1, -3), "[-3/1]"},
+		{constM(2, 2, 1, 1, 2), "[2/1 3/2]"},
+		{constM(2, 0, 1, 1, 0), "[2/1 -1/2]"},
+		{constM(3, 0, 0, 1, 0, 1, 0, 1, 0, 0), "[1/1 2/1 -1/2]"},
+		{constM(2, 0, 0, 0, 0), "[0/1 0/1]"},
+	} {
+		d, err := row.m.Diagonalize()
+		if err != nil {
+			t.Errorf("(\n%s\n).Diagonalize() returned error: %v", row.m, err)
+			continue
+		}
+		if got := fmt.Sprint(d); got != row.d {
+			t.Errorf("(\n%s\n).Diagonalize() = %s; want: %s", row.m, got, row.d)
+		}
+	}
+
+	for _, row := range []struct {
+		m   poly.Int64M
+		err error
+	}{
+		{constM(2, 1, 2, 3, 4), poly.NonSymmetricError},
+		{constM(2, 1, 2, 3, 4, 5, 6), poly.NonSymmetricError},
+		{poly.Int64M{[]poly.Int64P{{poly.Int64T{poly.Ind{1}, 1}}}, 1}, poly.NonConstantError},
+	} {
+		if _, err := row.m.Diagonalize(); err != row.err {
+			t.Errorf("(\n%s\n).Diagonalize() error = %v; want: %v", row.m, err, row.err)
+		}
+	}
+}
+
+func TestInt64MSignature(t *testing.T) {
+	for _, row := range []struct {
+		m poly.Int64M
+		s int
+	}{
+		{constM(2, 2, 1, 1, 2), 2},
+		{constM(2, 1, 2, 2, 1), 0},
+		{constM(2, 0, 1, 1, 0), 0},
+		{constM(3, -2, 1, 0, 1, -2, 1, 0, 1, -2), -3},
+		{constM(3, 1, 1, 1, 1, 1, 1, 1, 1, 1), 1},
+		{constM(3, 0, 0, 0, 0, 0, 0, 0, 0, 0), 0},
+	} {
+		s, err := row.m.Signature()
+		if err != nil {
+			t.Errorf("(\n%s\n).Signature() returned error: %v", row.m, err)
+			continue
+		}
+		if s != row.s {
+			t.Errorf("(\n%s\n).Signature() = %d; want: %d", row.m, s, row.s)
+		}
+	}
+}
+
+// constM creates a matrix with constant elements.
+func constM(stride uint, cs ...int64) poly.Int64M {
+	m := poly.Int64M{Stride: stride}
+	for _, c := range cs {
+		m.Elements = append(m.Elements, poly.Int64P{poly.Int64T{poly.Ind{}, c}})
+	}
+	return m
+}
+
+// mul multiplies two matrices.
+func mul(a, b poly.Int64M) poly.Int64M {
+	rows, n := len(a.Elements)/int(a.Stride), int(a.Stride)
+	ret := poly.Int64M{Elements: make([]poly.Int64P, rows*int(b.Stride)), Stride: b.Stride}
+	for i := range ret.Elements {
+		r, c := i/int(b.Stride), i%int(b.Stride)
+		p := poly.Int64P{}
+		for k := 0; k < n; k++ {
+			p = p.Add(a.Elements[r*n+k].Mul(b.Elements[k*int(b.Stride)+c]))
+		}
+		ret.Elements[i] = p.Compact()
+	}
+	return ret
+}
+
 func TestInt64MString(t *testing.T) {
 	for _, row := range []struct {
 		m poly.Int64M
@@ -15,10 +15,18 @@
 package poly
 
 import (
+	"errors"
 	"sort"
 	"strings"
 )
 
+var (
+	DivisionError     = errors.New("math error: inexact division")
+	ZeroDivisionError = errors.New("math error: division by zero")
+	NonConstantError  = errors.New("math error: non-constant polynomial")
+	NonSymmetricError = errors.New("math error: non-symmetric matrix")
+)
+
 // Int64P is a polynomial with int64 terms and coefficients.
 // This type implements a sparse representation, i.e. only non-zero terms are stored.
 type Int64P []Int64T
@@ -70,6 +78,284 @@ func (p Int64P) Compact() Int64P {
 	return ret
 }
 
+// DivMod divides the polynomial by 'x', so that p = q·x + r.
+// Terms are ordered the same way as when sorting, and a term of 'p' is divisible by the leading term of 'x' if
+// each of its exponents is at least as large and its coefficient is a multiple. No term of 'r' is divisible by the
+// leading term of 'x'. For univariate polynomials with a monic divisor, this is the usual long division.
+func (p Int64P) DivMod(x Int64P) (q, r Int64P, err error) {
+	size := width(p, x)
+	x = x.pad(size).Add(nil).trim()
+	if len(x) == 0 {
+		return nil, nil, ZeroDivisionError
+	}
+
+	q, r = Int64P{}, Int64P{}
+	for rest := p.pad(size).Add(nil).trim(); len(rest) > 0; rest = rest.trim() {
+		t, ok := rest[0].div(x[0])
+		if !ok {
+			r = append(r, rest[0])
+			rest = rest[1:]
+			continue
+		}
+		q = append(q, t)
+		t.C = -t.C
+		rest = rest.Add(x.MulT(t))
+	}
+
+	return q, r, nil
+}
+
+// Div calculates the exact quotient of two polynomials.
+// Unlike DivMod, the quotient may contain negative exponents, e.g. 1 / x = x¯¹.
+// If 'x' does not divide 'p', a DivisionError is returned.
+func (p Int64P) Div(x Int64P) (Int64P, error) {
+	size := width(p, x)
+	x = x.pad(size).Add(nil).trim()
+	if len(x) == 0 {
+		return nil, ZeroDivisionError
+	}
+	r := p.pad(size).Add(nil).trim()
+	if len(r) == 0 {
+		return r, nil
+	}
+
+	// The lowest term of the quotient is determined by the lowest terms of 'p' and 'x'.
+	lead, last := x[0], r[len(r)-1].Ind.div(x[len(x)-1].Ind)
+	ret := Int64P{}
+	for len(r) > 0 {
+		t := Int64T{r[0].Ind.div(lead.Ind), r[0].C / lead.C}
+		if r[0].C%lead.C != 0 || (Int64T{Ind: last}).Less(t) {
+			return nil, DivisionError
+		}
+		ret = append(ret, t)
+		t.C = -t.C
+		r = r.Add(x.MulT(t)).trim()
+	}
+
+	return ret, nil
+}
+
+// GCD calculates the greatest common divisor of two polynomials with integer coefficients.
+// Indeterminates are eliminated one at a time, using the primitive polynomial remainder sequence.
+// The result has a positive leading coefficient, and it is 0 only if both polynomials are 0.
+func (p Int64P) GCD(x Int64P) Int64P {
+	size := width(p, x)
+	p, x = p.pad(size).Add(nil).trim(), x.pad(size).Add(nil).trim()
+	if len(p) == 0 {
+		p, x = x, p
+	}
+	if len(p) == 0 {
+		return p
+	}
+
+	// Split off the monomial factors, so that the lowest exponent of each indeterminate is 0.
+	mp, mx := p.low(), x.low()
+	m := make(Ind, size)
+	for i := range m {
+		m[i] = mp[i]
+		if len(x) > 0 && mx[i] < m[i] {
+			m[i] = mx[i]
+		}
+	}
+	p = p.MulT(Int64T{make(Ind, size).div(mp), 1})
+	if len(x) > 0 {
+		x = x.MulT(Int64T{make(Ind, size).div(mx), 1})
+	}
+
+	return gcd(p, x, 0).MulT(Int64T{m, 1})
+}
+
+// gcd calculates the greatest common divisor of two polynomials without negative exponents, eliminating
+// indeterminates starting at 'v'. The result has a positive leading coefficient.
+func gcd(p, x Int64P, v int) Int64P {
+	if len(p) == 0 {
+		p, x = x, p
+	}
+	if len(x) == 0 {
+		return p.normalized()
+	}
+	size := len(p[0].Ind)
+	for v < size && p.deg(v) == 0 && x.deg(v) == 0 {
+		v++
+	}
+	if v == size {
+		// Both polynomials are constants.
+		c := gcdInt(p[0].C, x[0].C)
+		return Int64P{Int64T{make(Ind, size), c}}
+	}
+
+	// Treat both as polynomials in the v-th indeterminate, with coefficients in the rest.
+	cp, pp := p.primitive(v)
+	cx, px := x.primitive(v)
+	c := gcd(cp, cx, v+1)
+
+	if pp.deg(v) < px.deg(v) {
+		pp, px = px, pp
+	}
+	for len(px) > 0 && px.deg(v) > 0 {
+		pp, px = px, pp.prem(px, v)
+		if len(px) > 0 {
+			_, px = px.primitive(v)
+		}
+	}
+	if len(px) > 0 {
+		// The remainder sequence ended in a non-zero constant in the v-th indeterminate.
+		return c
+	}
+
+	return c.Mul(pp).normalized()
+}
+
+// primitive splits the polynomial into its content and primitive part, with respect to the v-th indeterminate.
+// The content is the greatest common divisor of the coefficients of each power of the v-th indeterminate.
+func (p Int64P) primitive(v int) (content, part Int64P) {
+	content = Int64P{}
+	for _, c := range p.coefficients(v) {
+		content = gcd(content, c, v+1)
+	}
+	part, err := p.Div(content)
+	if err != nil {
+		panic(err)
+	}
+
+	return content, part.normalized()
+}
+
+// prem calculates the pseudo-remainder of dividing 'p' by 'x', with respect to the v-th indeterminate.
+func (p Int64P) prem(x Int64P, v int) Int64P {
+	n := x.deg(v)
+	cx := x.coefficients(v)[n]
+	for len(p) > 0 && p.deg(v) >= n {
+		d := p.deg(v)
+		cp := p.coefficients(v)[d]
+		shift := make(Ind, len(x[0].Ind))
+		shift[v] = d - n
+		p = p.Mul(cx).Add(x.Mul(cp).MulT(Int64T{shift, -1})).trim()
+	}
+
+	return p
+}
+
+// coefficients returns the coefficient of each power of the v-th indeterminate.
+// The polynomial must not have negative exponents in the v-th indeterminate.
+func (p Int64P) coefficients(v int) []Int64P {
+	ret := make([]Int64P, p.deg(v)+1)
+	for i := range ret {
+		ret[i] = Int64P{}
+	}
+	for _, t := range p {
+		c := Int64T{make(Ind, len(t.Ind)), t.C}
+		copy(c.Ind, t.Ind)
+		c.Ind[v] = 0
+		ret[t.Ind[v]] = append(ret[t.Ind[v]], c)
+	}
+
+	return ret
+}
+
+// deg returns the highest exponent of the v-th indeterminate.
+func (p Int64P) deg(v int) int64 {
+	var ret int64
+	for i, t := range p {
+		if i == 0 || t.Ind[v] > ret {
+			ret = t.Ind[v]
+		}
+	}
+
+	return ret
+}
+
+// low returns the lowest exponent of each indeterminate.
+func (p Int64P) low() Ind {
+	ret := Ind{}
+	for i, t := range p {
+		if i == 0 {
+			ret = append(ret, t.Ind...)
+			continue
+		}
+		for v, e := range t.Ind {
+			if e < ret[v] {
+				ret[v] = e
+			}
+		}
+	}
+
+	return ret
+}
+
+// normalized returns the polynomial with its terms sorted and the leading coefficient made positive.
+func (p Int64P) normalized() Int64P {
+	p = Int64P{}.Add(p).trim()
+	if len(p) > 0 && p[0].C < 0 {
+		return p.MulT(Int64T{make(Ind, len(p[0].Ind)), -1})
+	}
+	return p
+}
+
+// width returns the length of the longest indeterminates in any of the polynomials.
+func width(ps ...Int64P) int {
+	ret := 0
+	for _, p := range ps {
+		for _, t := range p {
+			if len(t.Ind) > ret {
+				ret = len(t.Ind)
+			}
+		}
+	}
+
+	return ret
+}
+
+func gcdInt(a, b int64) int64 {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// constant returns the value of a constant polynomial.
+// If the polynomial has non-zero terms with indeterminates, false is returned.
+func (p Int64P) constant() (int64, bool) {
+	ret := int64(0)
+	for _, t := range append(Int64P{}, p...).Compact() {
+		for _, i := range t.Ind {
+			if i != 0 && t.C != 0 {
+				return 0, false
+			}
+		}
+		ret += t.C
+	}
+	return ret, true
+}
+
+// trim returns a copy of the polynomial with zero terms removed.
+func (p Int64P) trim() Int64P {
+	ret := Int64P{}
+	for _, t := range p {
+		if t.C != 0 {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+// pad returns a copy of the polynomial with all indeterminates extended to length 'size'.
+func (p Int64P) pad(size int) Int64P {
+	ret := make(Int64P, len(p))
+	for i, t := range p {
+		ret[i] = Int64T{make(Ind, size), t.C}
+		copy(ret[i].Ind, t.Ind)
+	}
+	return ret
+}
+
 // String returns a compact, human-readable representation of the polynomial.
 func (p Int64P) String() string {
 	terms := []string{}
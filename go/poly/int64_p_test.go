@@ -126,6 +126,156 @@ func TestInt64PMul(t *testing.T) {
 	}
 }
 
+func TestInt64PDivMod(t *testing.T) {
+	for _, row := range []struct {
+		a, b poly.Int64P
+		q, r string
+		err  error
+	}{
+		{
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{0}, -1}},
+			poly.Int64P{{poly.Ind{1}, 1}, {poly.Ind{0}, -1}},
+			"x + 1", "0", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{3}, 1}, {poly.Ind{1}, 2}, {poly.Ind{0}, 1}},
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{0}, 1}},
+			"x", "x + 1", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{2, 1}, 1}, {poly.Ind{1, 2}, 1}, {poly.Ind{0, 2}, 1}},
+			poly.Int64P{{poly.Ind{1, 1}, 1}, {poly.Ind{0, 0}, -1}},
+			"x + y", "x + y² + y", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{1}, 2}},
+			poly.Int64P{{poly.Ind{1}, 3}},
+			"0", "2x", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{1}, 2}},
+			poly.Int64P{{poly.Ind{1}, 0}},
+			"0", "0", poly.ZeroDivisionError,
+		},
+	} {
+		q, r, err := row.a.DivMod(row.b)
+		if err != row.err {
+			t.Errorf("(%q).DivMod(%q) error = %v; want %v", row.a, row.b, err, row.err)
+		}
+		if err != nil {
+			continue
+		}
+		if got, want := q.String(), row.q; got != want {
+			t.Errorf("(%q).DivMod(%q) q = %q; want %q", row.a, row.b, got, want)
+		}
+		if got, want := r.String(), row.r; got != want {
+			t.Errorf("(%q).DivMod(%q) r = %q; want %q", row.a, row.b, got, want)
+		}
+	}
+}
+
+func TestInt64PDiv(t *testing.T) {
+	for _, row := range []struct {
+		a, b poly.Int64P
+		s    string
+		err  error
+	}{
+		{
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{0}, -1}},
+			poly.Int64P{{poly.Ind{1}, 1}, {poly.Ind{0}, 1}},
+			"x - 1", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{0}, 1}},
+			poly.Int64P{{poly.Ind{1}, 1}},
+			"x¯¹", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{2, 1}, 1}, {poly.Ind{0, 1}, -1}},
+			poly.Int64P{{poly.Ind{1, 0}, 1}, {poly.Ind{0, 0}, -1}},
+			"xy + y", nil,
+		},
+		{
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{0}, 1}},
+			poly.Int64P{{poly.Ind{1}, 1}, {poly.Ind{0}, 1}},
+			"", poly.DivisionError,
+		},
+		{
+			poly.Int64P{{poly.Ind{1}, 2}},
+			poly.Int64P{{poly.Ind{0}, 4}},
+			"", poly.DivisionError,
+		},
+		{
+			poly.Int64P{{poly.Ind{1}, 2}},
+			poly.Int64P{},
+			"", poly.ZeroDivisionError,
+		},
+	} {
+		got, err := row.a.Div(row.b)
+		if err != row.err {
+			t.Errorf("(%q).Div(%q) error = %v; want %v", row.a, row.b, err, row.err)
+		}
+		if err != nil {
+			continue
+		}
+		if got, want := got.String(), row.s; got != want {
+			t.Errorf("(%q).Div(%q) = %q; want %q", row.a, row.b, got, want)
+		}
+	}
+}
+
+func TestInt64PGCD(t *testing.T) {
+	for _, row := range []struct {
+		a, b poly.Int64P
+		s    string
+	}{
+		{
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{0}, -1}},
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{1}, -2}, {poly.Ind{0}, 1}},
+			"x - 1",
+		},
+		{
+			poly.Int64P{{poly.Ind{1}, 6}, {poly.Ind{0}, 6}},
+			poly.Int64P{{poly.Ind{1}, 4}, {poly.Ind{0}, -4}},
+			"2",
+		},
+		{
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{1}, -1}, {poly.Ind{0}, 1}},
+			poly.Int64P{{poly.Ind{2}, 1}, {poly.Ind{1}, -3}, {poly.Ind{0}, 1}},
+			"1",
+		},
+		{
+			poly.Int64P{{poly.Ind{2, 1}, 1}, {poly.Ind{0, 1}, -1}},
+			poly.Int64P{{poly.Ind{1, 1}, 1}, {poly.Ind{0, 1}, 1}},
+			"xy + y",
+		},
+		{
+			poly.Int64P{{poly.Ind{3, 0}, 1}},
+			poly.Int64P{{poly.Ind{2, 1}, 1}},
+			"x²",
+		},
+		{
+			poly.Int64P{{poly.Ind{2, 2}, 1}, {poly.Ind{0, 0}, -1}},
+			poly.Int64P{{poly.Ind{2, 1}, 1}, {poly.Ind{1, 0}, -1}, {poly.Ind{1, 1}, 1}, {poly.Ind{0, 0}, -1}},
+			"xy - 1",
+		},
+		{
+			poly.Int64P{},
+			poly.Int64P{{poly.Ind{1}, -2}},
+			"2x",
+		},
+		{
+			poly.Int64P{},
+			poly.Int64P{},
+			"0",
+		},
+	} {
+		if got, want := row.a.GCD(row.b).String(), row.s; got != want {
+			t.Errorf("(%q).GCD(%q) = %q; want %q", row.a, row.b, got, want)
+		}
+	}
+}
+
 func TestInt64PCompact(t *testing.T) {
 	for _, row := range []struct {
 		p poly.Int64P
@@ -35,6 +35,21 @@ func (t Int64T) Mul(x Int64T) Int64T {
 	return t
 }
 
+// div returns the quotient of 't' and 'x', if it has no negative exponents and an integer coefficient.
+func (t Int64T) div(x Int64T) (Int64T, bool) {
+	if t.C%x.C != 0 {
+		return Int64T{}, false
+	}
+	ind := t.Ind.div(x.Ind)
+	for _, k := range ind {
+		if k < 0 {
+			return Int64T{}, false
+		}
+	}
+
+	return Int64T{ind, t.C / x.C}, true
+}
+
 // Less reports whether 't' should be sorted before 'x' in a polynomial.
 func (t Int64T) Less(x Int64T) bool {
 	for i, k := range t.Ind {
@@ -80,6 +95,16 @@ func (i Ind) Mul(x Ind) Ind {
 	return ret
 }
 
+// div returns the quotient of two indeterminates of the same length.
+func (i Ind) div(x Ind) Ind {
+	ret := make(Ind, len(i))
+	for k := range i {
+		ret[k] = i[k] - x[k]
+	}
+
+	return ret
+}
+
 // Eq checks two indeterminates for equality.
 func (i Ind) Eq(x Ind) bool {
 	if len(i) != len(x) {